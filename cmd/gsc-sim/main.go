@@ -0,0 +1,184 @@
+// Command gsc-sim plays complete games between bots without the GUI and reports score
+// distributions per area, win rates and average fox counts for each pick policy.
+//
+//	gsc-sim -games 5000 -seed 7 -players random,highest-value,fox-seeking -format json
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+// Report is the JSON output of a simulation run
+type Report struct {
+	Seed     uint64        `json:"seed"`
+	Games    int           `json:"games"`
+	Players  []string      `json:"players"`
+	Policies []PolicyStats `json:"policies"`
+}
+
+func main() {
+	games := flag.Int("games", 1000, "number of games to play")
+	seed := flag.Uint64("seed", 1, "random seed, the same seed replays the same games")
+	players := flag.String("players", strings.Join([]string{policyRandom, policyHighestValue, policyFoxSeeking}, ","),
+		"comma-separated pick policy for each seat (1-4): random, highest-value, fox-seeking, greedy or monte-carlo")
+	format := flag.String("format", "csv", "output format: csv or json")
+	out := flag.String("out", "", "output file, standard output when empty")
+	flag.Parse()
+
+	seats := strings.Split(*players, ",")
+	report, err := simulate(*games, *seed, seats)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gsc-sim:", err)
+		os.Exit(1)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "gsc-sim:", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	switch *format {
+	case "json":
+		err = writeJSON(w, report)
+	case "csv":
+		err = writeCSV(w, report)
+	default:
+		err = fmt.Errorf("unknown format %q, use csv or json", *format)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gsc-sim:", err)
+		os.Exit(1)
+	}
+}
+
+// simulate plays the games and collects the results by policy. Seats rotate every game so
+// no policy always starts. Winners follow the tie-break rule, and a shared win is split.
+func simulate(games int, seed uint64, seats []string) (*Report, error) {
+	if len(seats) < 1 || len(seats) > 4 {
+		return nil, fmt.Errorf("need 1-4 players, got %d", len(seats))
+	}
+	if games < 1 {
+		return nil, fmt.Errorf("need at least one game, got %d", games)
+	}
+
+	dice := rand.New(rand.NewPCG(seed, 0))
+	choices := rand.New(rand.NewPCG(seed, 1))
+	ruleset := game.DefaultRuleset()
+
+	results := make(map[string]*policyResults)
+	for _, seat := range seats {
+		if _, err := newPolicy(seat, choices); err != nil {
+			return nil, err
+		}
+		if results[seat] == nil {
+			results[seat] = newPolicyResults()
+		}
+	}
+
+	for g := range games {
+		players := make([]*game.Player, len(seats))
+		policies := make([]string, len(seats))
+		for i := range seats {
+			policy := seats[(i+g)%len(seats)]
+			strategy, _ := newPolicy(policy, choices)
+			policies[i] = policy
+			players[i] = game.NewBot(fmt.Sprintf("%s #%d", policy, i+1), strategy, ruleset)
+		}
+
+		if err := game.PlayBotGame(players, dice); err != nil {
+			return nil, fmt.Errorf("game %d: %w", g+1, err)
+		}
+
+		winners := game.Winners(players)
+
+		for i, player := range players {
+			result := results[policies[i]]
+			sheet := player.ScoreSheet
+			result.games++
+			if slices.Contains(winners, player) {
+				result.wins += 1 / float64(len(winners))
+			}
+			result.foxes += sheet.Bonus.FoxCount
+			for _, area := range ruleset.Areas() {
+				result.scores[string(area)] = append(result.scores[string(area)], sheet.AreaTotal(area))
+			}
+			result.scores["bonus"] = append(result.scores["bonus"], sheet.Bonus.Total)
+			result.scores["total"] = append(result.scores["total"], sheet.GetTotalScore())
+		}
+	}
+
+	report := &Report{Seed: seed, Games: games, Players: seats}
+	for _, policy := range uniquePolicies(seats) {
+		report.Policies = append(report.Policies, results[policy].stats(policy))
+	}
+	return report, nil
+}
+
+// uniquePolicies returns the policies in seat order without repeats
+func uniquePolicies(seats []string) []string {
+	var policies []string
+	for _, seat := range seats {
+		if !slices.Contains(policies, seat) {
+			policies = append(policies, seat)
+		}
+	}
+	return policies
+}
+
+// scoreColumns lists the score distributions in output order
+func scoreColumns() []string {
+	var columns []string
+	for _, area := range game.DefaultRuleset().Areas() {
+		columns = append(columns, string(area))
+	}
+	return append(columns, "bonus", "total")
+}
+
+func writeJSON(w io.Writer, report *Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+// writeCSV writes one row per policy and score column, repeating the policy summary on each row
+func writeCSV(w io.Writer, report *Report) error {
+	cw := csv.NewWriter(w)
+	header := []string{"policy", "games", "wins", "win_rate", "mean_foxes", "score", "mean", "std_dev", "min", "p25", "median", "p75", "max"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	float := func(v float64) string { return strconv.FormatFloat(v, 'f', 3, 64) }
+	for _, stats := range report.Policies {
+		for _, column := range scoreColumns() {
+			d := stats.Scores[column]
+			row := []string{
+				stats.Policy, strconv.Itoa(stats.Games), float(stats.Wins), float(stats.WinRate), float(stats.MeanFoxes),
+				column, float(d.Mean), float(d.StdDev),
+				strconv.Itoa(d.Min), strconv.Itoa(d.P25), strconv.Itoa(d.Median), strconv.Itoa(d.P75), strconv.Itoa(d.Max),
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+// Built-in pick policies, on top of the bot strategies from the game package
+const (
+	policyRandom       = "random"
+	policyHighestValue = "highest-value"
+	policyFoxSeeking   = "fox-seeking"
+)
+
+// newPolicy returns the strategy for a policy name
+func newPolicy(name string, roller game.Roller) (game.Strategy, error) {
+	switch name {
+	case policyRandom:
+		return randomPolicy{roller: roller}, nil
+	case policyHighestValue:
+		return highestValuePolicy{}, nil
+	case policyFoxSeeking:
+		return foxSeekingPolicy{}, nil
+	default:
+		strategy, err := game.NewStrategy(name, roller)
+		if err != nil {
+			return nil, fmt.Errorf("unknown policy %q, use %s, %s, %s, %s or %s", name,
+				policyRandom, policyHighestValue, policyFoxSeeking, game.StrategyGreedy, game.StrategyMonteCarlo)
+		}
+		return strategy, nil
+	}
+}
+
+// randomPolicy takes any legal move
+type randomPolicy struct {
+	roller game.Roller
+}
+
+func (randomPolicy) ID() string   { return policyRandom }
+func (randomPolicy) Name() string { return "Random" }
+
+func (p randomPolicy) Choose(_ *game.Player, _ *game.Turn, moves []game.Move) (game.Move, bool) {
+	if len(moves) == 0 {
+		return game.Move{}, false
+	}
+	return moves[p.roller.IntN(len(moves))], true
+}
+
+// highestValuePolicy takes the highest die, breaking ties by the points scored
+type highestValuePolicy struct{}
+
+func (highestValuePolicy) ID() string   { return policyHighestValue }
+func (highestValuePolicy) Name() string { return "Highest value" }
+
+func (highestValuePolicy) Choose(_ *game.Player, _ *game.Turn, moves []game.Move) (game.Move, bool) {
+	return bestMove(moves, func(m game.Move) int {
+		return m.Value*100 + m.Points
+	})
+}
+
+// foxSeekingPolicy takes any move that unlocks a fox, otherwise the one scoring the most points
+type foxSeekingPolicy struct{}
+
+func (foxSeekingPolicy) ID() string   { return policyFoxSeeking }
+func (foxSeekingPolicy) Name() string { return "Fox seeking" }
+
+func (foxSeekingPolicy) Choose(_ *game.Player, _ *game.Turn, moves []game.Move) (game.Move, bool) {
+	return bestMove(moves, func(m game.Move) int {
+		score := m.Points
+		for _, reward := range m.Rewards {
+			if reward.Kind == game.RewardFox {
+				score += 1000
+			}
+		}
+		return score
+	})
+}
+
+// bestMove returns the first move with the highest score
+func bestMove(moves []game.Move, score func(game.Move) int) (game.Move, bool) {
+	if len(moves) == 0 {
+		return game.Move{}, false
+	}
+	best, bestScore := moves[0], score(moves[0])
+	for _, move := range moves[1:] {
+		if s := score(move); s > bestScore {
+			best, bestScore = move, s
+		}
+	}
+	return best, true
+}
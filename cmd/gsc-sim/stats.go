@@ -0,0 +1,89 @@
+package main
+
+import (
+	"math"
+	"slices"
+)
+
+// Distribution summarises a set of scores
+type Distribution struct {
+	Mean   float64 `json:"mean"`
+	StdDev float64 `json:"std_dev"`
+	Min    int     `json:"min"`
+	P25    int     `json:"p25"`
+	Median int     `json:"median"`
+	P75    int     `json:"p75"`
+	Max    int     `json:"max"`
+}
+
+// newDistribution summarises the values, which it sorts in place
+func newDistribution(values []int) Distribution {
+	if len(values) == 0 {
+		return Distribution{}
+	}
+	slices.Sort(values)
+
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	mean := float64(sum) / float64(len(values))
+
+	variance := 0.0
+	for _, v := range values {
+		variance += (float64(v) - mean) * (float64(v) - mean)
+	}
+	variance /= float64(len(values))
+
+	percentile := func(p float64) int {
+		return values[int(p*float64(len(values)-1))]
+	}
+	return Distribution{
+		Mean:   mean,
+		StdDev: math.Sqrt(variance),
+		Min:    values[0],
+		P25:    percentile(0.25),
+		Median: percentile(0.5),
+		P75:    percentile(0.75),
+		Max:    values[len(values)-1],
+	}
+}
+
+// policyResults collects the scores of every seat played with one policy
+type policyResults struct {
+	games  int
+	wins   float64 // shared wins count as a fraction
+	foxes  int
+	scores map[string][]int // by area, plus bonus and total
+}
+
+func newPolicyResults() *policyResults {
+	return &policyResults{scores: make(map[string][]int)}
+}
+
+// PolicyStats is the summary reported for one policy
+type PolicyStats struct {
+	Policy    string                  `json:"policy"`
+	Games     int                     `json:"games"`
+	Wins      float64                 `json:"wins"`
+	WinRate   float64                 `json:"win_rate"`
+	MeanFoxes float64                 `json:"mean_foxes"`
+	Scores    map[string]Distribution `json:"scores"`
+}
+
+func (pr *policyResults) stats(policy string) PolicyStats {
+	stats := PolicyStats{
+		Policy: policy,
+		Games:  pr.games,
+		Wins:   pr.wins,
+		Scores: make(map[string]Distribution, len(pr.scores)),
+	}
+	if pr.games > 0 {
+		stats.WinRate = pr.wins / float64(pr.games)
+		stats.MeanFoxes = float64(pr.foxes) / float64(pr.games)
+	}
+	for area, values := range pr.scores {
+		stats.Scores[area] = newDistribution(values)
+	}
+	return stats
+}
@@ -0,0 +1,87 @@
+package game
+
+import "errors"
+
+// ErrNoActionAvailable is returned when spending a reroll or +1 action that has not been earned
+var ErrNoActionAvailable = errors.New("no action available")
+
+// ErrNoActionUsed is returned when refunding an action that was never spent
+var ErrNoActionUsed = errors.New("no action has been used")
+
+// ActionTrack counts the reroll or +1 actions a player has earned and spent
+type ActionTrack struct {
+	FromMarks  int // derived from rewards on the sheet
+	FromRounds int // earned at round start
+	Granted    int // added by hand
+	Used       int
+}
+
+// Earned returns the total number of actions earned
+func (at *ActionTrack) Earned() int {
+	return at.FromMarks + at.FromRounds + at.Granted
+}
+
+// Available returns the number of actions that can still be spent
+func (at *ActionTrack) Available() int {
+	return at.Earned() - at.Used
+}
+
+// Grant adds an earned action by hand, outside of the sheet and round rewards
+func (at *ActionTrack) Grant() {
+	at.Granted++
+}
+
+// Use spends one action
+func (at *ActionTrack) Use() error {
+	if at.Available() <= 0 {
+		return ErrNoActionAvailable
+	}
+	at.Used++
+	return nil
+}
+
+// Refund gives back the last spent action, for correcting a mistaken tap
+func (at *ActionTrack) Refund() error {
+	if at.Used <= 0 {
+		return ErrNoActionUsed
+	}
+	at.Used--
+	return nil
+}
+
+// roundRewards are printed on the round track, indexed by round number
+var roundRewards = map[int][]Reward{
+	1: {{Kind: RewardReroll, Source: "round 1"}},
+	2: {{Kind: RewardPlusOne, Source: "round 2"}},
+	3: {{Kind: RewardReroll, Source: "round 3"}},
+	4: {{Kind: RewardFreeMark, Value: 6, Source: "round 4"}},
+}
+
+// RoundRewards returns the rewards every player receives at the start of a round.
+// The round 4 free mark has no colour: the player chooses which area gets the 6.
+func RoundRewards(round int) []Reward {
+	return roundRewards[round]
+}
+
+// StartRound grants the round-start rewards for every round up to and including round
+// that has not been started yet. Rerolls and +1 actions are added to the tracks, the
+// remaining rewards are returned for the player to apply.
+func (ss *ScoreSheet) StartRound(round int) []Reward {
+	var pending []Reward
+	for r := ss.Round + 1; r <= round; r++ {
+		for _, reward := range RoundRewards(r) {
+			switch reward.Kind {
+			case RewardReroll:
+				ss.Rerolls.FromRounds++
+			case RewardPlusOne:
+				ss.PlusOnes.FromRounds++
+			default:
+				pending = append(pending, reward)
+			}
+		}
+	}
+	if round > ss.Round {
+		ss.Round = round
+	}
+	return pending
+}
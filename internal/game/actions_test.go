@@ -0,0 +1,77 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestActionTrack(t *testing.T) {
+	track := &ActionTrack{}
+	if err := track.Use(); !errors.Is(err, ErrNoActionAvailable) {
+		t.Errorf("Use with nothing earned: error = %v, want ErrNoActionAvailable", err)
+	}
+	if err := track.Refund(); !errors.Is(err, ErrNoActionUsed) {
+		t.Errorf("Refund with nothing used: error = %v, want ErrNoActionUsed", err)
+	}
+	if track.Used != 0 || track.Available() != 0 {
+		t.Errorf("failed calls changed the track to %+v", track)
+	}
+
+	track.FromMarks = 1
+	track.FromRounds = 1
+	track.Grant()
+	if track.Earned() != 3 || track.Available() != 3 {
+		t.Errorf("earned %d, available %d, want 3 from marks, rounds and by hand", track.Earned(), track.Available())
+	}
+	for range 3 {
+		if err := track.Use(); err != nil {
+			t.Fatalf("Use: %v", err)
+		}
+	}
+	if err := track.Use(); !errors.Is(err, ErrNoActionAvailable) {
+		t.Errorf("Use after spending every action: error = %v, want ErrNoActionAvailable", err)
+	}
+	if err := track.Refund(); err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+	if track.Used != 2 || track.Available() != 1 {
+		t.Errorf("used %d, available %d after a refund, want 2 and 1", track.Used, track.Available())
+	}
+}
+
+func TestActionsEarnedPerRound(t *testing.T) {
+	tests := []struct {
+		round            int
+		rerolls, plusOne int
+		pending          []Reward
+	}{
+		{1, 1, 0, nil},
+		{2, 1, 1, nil},
+		{3, 2, 1, nil},
+		{4, 2, 1, []Reward{{Kind: RewardFreeMark, Value: 6, Source: "round 4"}}},
+		{6, 2, 1, nil},
+	}
+
+	ss := NewScoreSheet()
+	for _, tt := range tests {
+		pending := ss.StartRound(tt.round)
+		if ss.Rerolls.FromRounds != tt.rerolls || ss.PlusOnes.FromRounds != tt.plusOne {
+			t.Errorf("by round %d: %d rerolls and %d +1 actions, want %d and %d",
+				tt.round, ss.Rerolls.FromRounds, ss.PlusOnes.FromRounds, tt.rerolls, tt.plusOne)
+		}
+		if len(pending) != len(tt.pending) || (len(pending) > 0 && pending[0] != tt.pending[0]) {
+			t.Errorf("round %d left %v to apply, want %v", tt.round, pending, tt.pending)
+		}
+	}
+
+	// Skipping ahead grants every round in between
+	skipped := NewScoreSheet()
+	pending := skipped.StartRound(4)
+	if skipped.Rerolls.FromRounds != 2 || skipped.PlusOnes.FromRounds != 1 || len(pending) != 1 {
+		t.Errorf("starting round 4 directly: %d rerolls, %d +1 actions and %v pending, want 2, 1 and the free 6",
+			skipped.Rerolls.FromRounds, skipped.PlusOnes.FromRounds, pending)
+	}
+	if err := skipped.Rerolls.Use(); err != nil {
+		t.Errorf("Use of a reroll earned by round: %v", err)
+	}
+}
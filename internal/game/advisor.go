@@ -0,0 +1,54 @@
+package game
+
+import "slices"
+
+// DefaultAdvisorRollouts is the number of games the advisor simulates per candidate move
+const DefaultAdvisorRollouts = 60
+
+// Suggestion is a legal move ranked by the final score it is expected to lead to
+type Suggestion struct {
+	Move
+	Expected float64 // average final score over the simulated games
+}
+
+// Advisor ranks the picks for a roll by simulating the rest of the game after each one
+type Advisor struct {
+	simulator *MonteCarlo
+}
+
+// NewAdvisor creates an advisor that simulates the rest of the game with the given dice
+func NewAdvisor(roller Roller, rollouts int) *Advisor {
+	return &Advisor{simulator: NewMonteCarlo(roller, rollouts)}
+}
+
+// Suggest ranks the legal moves of the available dice for the player, best first, and returns
+// at most count of them, none for a negative count. The situation tells the simulation how
+// many rounds and opponents are left; a zero situation scores each move without simulating
+// later rounds.
+func (a *Advisor) Suggest(player *Player, dice Dice, available []Color, situation Situation, count int) ([]Suggestion, error) {
+	moves := player.ScoreSheet.LegalMoves(dice, available)
+	if len(moves) == 0 {
+		return nil, nil
+	}
+
+	suggestions := make([]Suggestion, 0, len(moves))
+	for _, move := range moves {
+		expected, err := a.simulator.estimate(player, nil, situation, move)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, Suggestion{Move: move, Expected: expected})
+	}
+	slices.SortStableFunc(suggestions, func(x, y Suggestion) int {
+		switch {
+		case x.Expected > y.Expected:
+			return -1
+		case x.Expected < y.Expected:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return suggestions[:min(max(count, 0), len(suggestions))], nil
+}
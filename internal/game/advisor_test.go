@@ -0,0 +1,28 @@
+package game
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSuggestReturnsAtMostCount(t *testing.T) {
+	player := NewPlayer("Alice")
+	dice := Dice{ColorWhite: 6, ColorYellow: 1, ColorBlue: 2, ColorGreen: 4, ColorOrange: 3, ColorPurple: 2}
+	advisor := NewAdvisor(rand.New(rand.NewPCG(5, 0)), 2)
+	legal := len(player.ScoreSheet.LegalMoves(dice, DiceColors()))
+
+	for count, want := range map[int]int{-1: 0, 0: 0, 3: 3, legal + 5: legal} {
+		suggestions, err := advisor.Suggest(player, dice, DiceColors(), Situation{}, count)
+		if err != nil {
+			t.Fatalf("Suggest(%d): %v", count, err)
+		}
+		if len(suggestions) != want {
+			t.Errorf("Suggest(%d) returned %d suggestions, want %d", count, len(suggestions), want)
+		}
+		for i := 1; i < len(suggestions); i++ {
+			if suggestions[i].Expected > suggestions[i-1].Expected {
+				t.Errorf("Suggest(%d) is not ranked best first: %v", count, suggestions)
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package game
+
+const (
+	// BlueRows is the number of rows in the blue grid
+	BlueRows = 3
+	// BlueColumns is the number of columns in the blue grid
+	BlueColumns = 4
+	// BlueMinSum and BlueMaxSum bound the sum of the blue and white dice
+	BlueMinSum = 2
+	BlueMaxSum = 12
+)
+
+// blueSums is the printed blue grid, row by row.
+// A zero marks the top-left box that is crossed off before the game starts.
+var blueSums = [BlueRows][BlueColumns]int{
+	{0, 2, 3, 4},
+	{5, 6, 7, 8},
+	{9, 10, 11, 12},
+}
+
+// bluePoints maps the number of crossed blue boxes to points
+var bluePoints = [12]int{0, 1, 2, 4, 7, 11, 16, 22, 29, 37, 46, 56}
+
+// BlueLine identifies a row or column of the blue grid
+type BlueLine struct {
+	Column bool // false for a row, true for a column
+	Index  int
+}
+
+type BlueScoreArea struct {
+	Total int
+	Marks [BlueMaxSum + 1]bool // indexed by dice sum, only 2-12 are used
+}
+
+// NewBlueScoreArea creates an empty blue grid
+func NewBlueScoreArea() *BlueScoreArea {
+	return &BlueScoreArea{}
+}
+
+func (bsa *BlueScoreArea) Record(score int) {
+	bsa.Total = score
+}
+
+// BlueSum returns the dice sum printed in a blue box, or 0 for the pre-crossed box
+func BlueSum(row, col int) int {
+	if row < 0 || row >= BlueRows || col < 0 || col >= BlueColumns {
+		return 0
+	}
+	return blueSums[row][col]
+}
+
+// BluePosition returns the row and column of the blue box for a dice sum
+func BluePosition(sum int) (row, col int, ok bool) {
+	if sum < BlueMinSum || sum > BlueMaxSum {
+		return 0, 0, false
+	}
+	// Sums fill the grid row by row after the pre-crossed box
+	return (sum - 1) / BlueColumns, (sum - 1) % BlueColumns, true
+}
+
+// BluePoints returns the points for a number of crossed blue boxes
+func BluePoints(count int) int {
+	if count < 0 {
+		return 0
+	}
+	if count >= len(bluePoints) {
+		count = len(bluePoints) - 1
+	}
+	return bluePoints[count]
+}
+
+// IsMarked reports whether the blue box for a dice sum is crossed off
+func (bsa *BlueScoreArea) IsMarked(sum int) bool {
+	return sum >= BlueMinSum && sum <= BlueMaxSum && bsa.Marks[sum]
+}
+
+// Count returns the number of crossed blue boxes
+func (bsa *BlueScoreArea) Count() int {
+	count := 0
+	for sum := BlueMinSum; sum <= BlueMaxSum; sum++ {
+		if bsa.Marks[sum] {
+			count++
+		}
+	}
+	return count
+}
+
+// CanMark reports whether the blue box for a dice sum may be crossed off
+func (bsa *BlueScoreArea) CanMark(sum int) error {
+	if sum < BlueMinSum || sum > BlueMaxSum {
+		return ErrBoxOutOfRange
+	}
+	if bsa.Marks[sum] {
+		return ErrAlreadyMarked
+	}
+	return nil
+}
+
+// Mark crosses off the blue box for a dice sum and updates the total
+func (bsa *BlueScoreArea) Mark(sum int) error {
+	if err := bsa.CanMark(sum); err != nil {
+		return err
+	}
+	bsa.Marks[sum] = true
+	bsa.Total = calculateBlueScore(bsa)
+	return nil
+}
+
+// Unmark removes the cross from the blue box for a dice sum and updates the total
+func (bsa *BlueScoreArea) Unmark(sum int) error {
+	if sum < BlueMinSum || sum > BlueMaxSum {
+		return ErrBoxOutOfRange
+	}
+	if !bsa.Marks[sum] {
+		return ErrNotMarked
+	}
+	bsa.Marks[sum] = false
+	bsa.Total = calculateBlueScore(bsa)
+	return nil
+}
+
+// RowComplete reports whether every box in a blue row is crossed off
+func (bsa *BlueScoreArea) RowComplete(row int) bool {
+	if row < 0 || row >= BlueRows {
+		return false
+	}
+	for col := range BlueColumns {
+		if sum := blueSums[row][col]; sum != 0 && !bsa.Marks[sum] {
+			return false
+		}
+	}
+	return true
+}
+
+// ColumnComplete reports whether every box in a blue column is crossed off
+func (bsa *BlueScoreArea) ColumnComplete(col int) bool {
+	if col < 0 || col >= BlueColumns {
+		return false
+	}
+	for row := range BlueRows {
+		if sum := blueSums[row][col]; sum != 0 && !bsa.Marks[sum] {
+			return false
+		}
+	}
+	return true
+}
+
+// LinesCompletedBy returns the row and column through the box for a dice sum that are now complete.
+// Called right after marking, these are exactly the lines the mark has just completed and whose
+// printed bonuses should be awarded.
+func (bsa *BlueScoreArea) LinesCompletedBy(sum int) []BlueLine {
+	row, col, ok := BluePosition(sum)
+	if !ok || !bsa.Marks[sum] {
+		return nil
+	}
+
+	var lines []BlueLine
+	if bsa.RowComplete(row) {
+		lines = append(lines, BlueLine{Index: row})
+	}
+	if bsa.ColumnComplete(col) {
+		lines = append(lines, BlueLine{Column: true, Index: col})
+	}
+	return lines
+}
+
+func calculateBlueScore(blue *BlueScoreArea) int {
+	return BluePoints(blue.Count())
+}
@@ -0,0 +1,243 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrNeedsBoxChoice is returned when a free mark must be placed on a box the player chooses
+var ErrNeedsBoxChoice = errors.New("reward needs a box to be chosen")
+
+// Color identifies a scoring area, and later the die of the same colour
+type Color string
+
+const (
+	ColorYellow Color = "yellow"
+	ColorGreen  Color = "green"
+	ColorOrange Color = "orange"
+	ColorPurple Color = "purple"
+	ColorBlue   Color = "blue"
+)
+
+// RewardKind is the type of reward printed on the score sheet
+type RewardKind int
+
+const (
+	RewardFox RewardKind = iota
+	RewardReroll
+	RewardPlusOne
+	RewardFreeMark
+)
+
+// Reward is a bonus unlocked by completing a row, column or box on the score sheet
+type Reward struct {
+	Kind   RewardKind
+	Color  Color  // area for a free mark
+	Value  int    // die value for a free orange or purple mark, 0 for a plain cross
+	Source string // where the reward is printed, unique per sheet
+}
+
+// Automatic reports whether the reward is applied by the engine without a player decision
+func (r Reward) Automatic() bool {
+	return r.Kind != RewardFreeMark
+}
+
+func (r Reward) String() string {
+	switch r.Kind {
+	case RewardFox:
+		return "🦊 Fox"
+	case RewardReroll:
+		return "🔄 Reroll"
+	case RewardPlusOne:
+		return "➕ +1 action"
+	case RewardFreeMark:
+		if r.Color == "" {
+			return fmt.Sprintf("✖ Free %d in any colour", r.Value)
+		}
+		if r.Value > 0 {
+			return fmt.Sprintf("✖ Free %s %d", r.Color, r.Value)
+		}
+		return fmt.Sprintf("✖ Free %s cross", r.Color)
+	default:
+		return "Unknown reward"
+	}
+}
+
+// countReward is a reward earned once an area holds a number of entries
+type countReward struct {
+	count  int
+	reward Reward
+}
+
+var greenRewards = []countReward{
+	{4, Reward{Kind: RewardPlusOne}},
+	{6, Reward{Kind: RewardFreeMark, Color: ColorBlue}},
+	{7, Reward{Kind: RewardFox}},
+	{9, Reward{Kind: RewardFreeMark, Color: ColorPurple, Value: 6}},
+	{10, Reward{Kind: RewardReroll}},
+}
+
+var orangeRewards = []countReward{
+	{3, Reward{Kind: RewardReroll}},
+	{5, Reward{Kind: RewardFreeMark, Color: ColorYellow}},
+	{6, Reward{Kind: RewardPlusOne}},
+	{8, Reward{Kind: RewardFox}},
+	{10, Reward{Kind: RewardFreeMark, Color: ColorPurple, Value: 6}},
+}
+
+var purpleRewards = []countReward{
+	{3, Reward{Kind: RewardReroll}},
+	{4, Reward{Kind: RewardFreeMark, Color: ColorBlue}},
+	{5, Reward{Kind: RewardPlusOne}},
+	{6, Reward{Kind: RewardFreeMark, Color: ColorYellow}},
+	{7, Reward{Kind: RewardFox}},
+	{8, Reward{Kind: RewardReroll}},
+	{9, Reward{Kind: RewardFreeMark, Color: ColorGreen}},
+	{10, Reward{Kind: RewardFreeMark, Color: ColorOrange, Value: 6}},
+	{11, Reward{Kind: RewardPlusOne}},
+}
+
+// yellowRowRewards are printed at the end of each yellow row
+var yellowRowRewards = [YellowSize]Reward{
+	{Kind: RewardFreeMark, Color: ColorBlue},
+	{Kind: RewardFreeMark, Color: ColorOrange, Value: 4},
+	{Kind: RewardFreeMark, Color: ColorGreen},
+	{Kind: RewardFox},
+}
+
+// yellowDiagonalReward is printed at the end of the yellow diagonal
+var yellowDiagonalReward = Reward{Kind: RewardPlusOne}
+
+// blueRowRewards are printed at the end of each blue row
+var blueRowRewards = [BlueRows]Reward{
+	{Kind: RewardFreeMark, Color: ColorOrange, Value: 5},
+	{Kind: RewardFreeMark, Color: ColorYellow},
+	{Kind: RewardFox},
+}
+
+// blueColumnRewards are printed below each blue column
+var blueColumnRewards = [BlueColumns]Reward{
+	{Kind: RewardReroll},
+	{Kind: RewardFreeMark, Color: ColorGreen},
+	{Kind: RewardFreeMark, Color: ColorPurple, Value: 6},
+	{Kind: RewardPlusOne},
+}
+
+// Rewards returns every reward earned by the marks currently on the sheet
+func (ss *ScoreSheet) Rewards() []Reward {
+	var rewards []Reward
+	earn := func(reward Reward, source string) {
+		reward.Source = source
+		rewards = append(rewards, reward)
+	}
+
+	for row := range YellowSize {
+		if ss.Yellow.RowComplete(row) {
+			earn(yellowRowRewards[row], "yellow row "+strconv.Itoa(row+1))
+		}
+	}
+	if ss.Yellow.DiagonalComplete() {
+		earn(yellowDiagonalReward, "yellow diagonal")
+	}
+
+	earnByCount := func(color Color, table []countReward, count int) {
+		for _, cr := range table {
+			if count >= cr.count {
+				earn(cr.reward, string(color)+" box "+strconv.Itoa(cr.count))
+			}
+		}
+	}
+	earnByCount(ColorGreen, greenRewards, len(ss.Green.Values))
+	earnByCount(ColorOrange, orangeRewards, len(ss.Orange.Values))
+	earnByCount(ColorPurple, purpleRewards, len(ss.Purple.Values))
+
+	for row := range BlueRows {
+		if ss.Blue.RowComplete(row) {
+			earn(blueRowRewards[row], "blue row "+strconv.Itoa(row+1))
+		}
+	}
+	for col := range BlueColumns {
+		if ss.Blue.ColumnComplete(col) {
+			earn(blueColumnRewards[col], "blue column "+strconv.Itoa(col+1))
+		}
+	}
+
+	return rewards
+}
+
+// EarnedFoxes counts the foxes unlocked by the marks on the sheet
+func (ss *ScoreSheet) EarnedFoxes() int {
+	return ss.countRewards(RewardFox)
+}
+
+func (ss *ScoreSheet) countRewards(kind RewardKind) int {
+	count := 0
+	for _, reward := range ss.Rewards() {
+		if reward.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+// recordMark applies a mark and returns the rewards it unlocked.
+// Foxes, rerolls and +1 actions are applied automatically, free marks are left to the caller.
+func (ss *ScoreSheet) recordMark(mark func() error) ([]Reward, error) {
+	before := make(map[string]bool)
+	for _, reward := range ss.Rewards() {
+		before[reward.Source] = true
+	}
+
+	if err := mark(); err != nil {
+		return nil, err
+	}
+
+	var triggered []Reward
+	for _, reward := range ss.Rewards() {
+		if !before[reward.Source] {
+			triggered = append(triggered, reward)
+		}
+	}
+
+	ss.recalculate()
+	return triggered, nil
+}
+
+// removeMark removes a mark and re-derives everything that depends on it
+func (ss *ScoreSheet) removeMark(unmark func() error) error {
+	if err := unmark(); err != nil {
+		return err
+	}
+	ss.recalculate()
+	return nil
+}
+
+// recalculate derives the fox count and earned actions from the marks and updates the bonus
+func (ss *ScoreSheet) recalculate() {
+	ss.Bonus.FoxCount = ss.EarnedFoxes()
+	ss.Rerolls.FromMarks = ss.countRewards(RewardReroll)
+	ss.PlusOnes.FromMarks = ss.countRewards(RewardPlusOne)
+	ss.CalculateBonus()
+}
+
+// ApplyFreeMark enters a free mark whose target is fixed by the sheet: the next green box,
+// or a printed orange or purple value. It returns any rewards unlocked in turn, so chained
+// bonuses can be applied one after another. Free yellow and blue crosses need a chosen box
+// and must be entered with MarkYellow or MarkBlue instead.
+func (ss *ScoreSheet) ApplyFreeMark(reward Reward) ([]Reward, error) {
+	if reward.Kind != RewardFreeMark {
+		return nil, fmt.Errorf("%s is not a free mark", reward)
+	}
+
+	switch reward.Color {
+	case ColorGreen:
+		return ss.recordMark(ss.Green.MarkFree)
+	case ColorOrange:
+		return ss.MarkOrange(reward.Value)
+	case ColorPurple:
+		return ss.MarkPurple(reward.Value)
+	default:
+		return nil, ErrNeedsBoxChoice
+	}
+}
@@ -0,0 +1,126 @@
+package game
+
+import "testing"
+
+func TestMarksReturnTheRewardsTheyUnlock(t *testing.T) {
+	ss := NewScoreSheet()
+	for i, value := range []int{1, 2} {
+		if rewards, err := ss.MarkOrange(value); err != nil || len(rewards) != 0 {
+			t.Fatalf("orange %d: rewards %v, error %v, want none", i+1, rewards, err)
+		}
+	}
+
+	rewards, err := ss.MarkOrange(3)
+	if err != nil {
+		t.Fatalf("MarkOrange: %v", err)
+	}
+	if len(rewards) != 1 || rewards[0] != (Reward{Kind: RewardReroll, Source: "orange box 3"}) {
+		t.Errorf("third orange unlocked %v, want the reroll of orange box 3", rewards)
+	}
+	if ss.Rerolls.FromMarks != 1 || ss.Rerolls.Available() != 1 {
+		t.Errorf("rerolls from marks %d, available %d, want 1 and 1", ss.Rerolls.FromMarks, ss.Rerolls.Available())
+	}
+
+	// A reward is only triggered by the mark that unlocks it
+	if rewards, err := ss.MarkOrange(4); err != nil || len(rewards) != 0 {
+		t.Errorf("fourth orange: rewards %v, error %v, want none", rewards, err)
+	}
+
+	// Taking the mark back takes the reroll back
+	for range 2 {
+		if err := ss.UnmarkOrange(); err != nil {
+			t.Fatalf("UnmarkOrange: %v", err)
+		}
+	}
+	if ss.Rerolls.FromMarks != 0 {
+		t.Errorf("rerolls from marks = %d after unmarking, want 0", ss.Rerolls.FromMarks)
+	}
+}
+
+func TestFoxRewardCountsTowardsTheBonus(t *testing.T) {
+	ss := NewScoreSheet()
+	for _, value := range []int{6, 6} {
+		if _, err := ss.MarkOrange(value); err != nil {
+			t.Fatalf("MarkOrange: %v", err)
+		}
+	}
+	// Every area needs a score for the fox to be worth anything
+	for _, mark := range []func() ([]Reward, error){
+		func() ([]Reward, error) { return ss.MarkGreen(1) },
+		func() ([]Reward, error) { return ss.MarkPurple(1) },
+		func() ([]Reward, error) { return ss.MarkBlue(2) },
+		func() ([]Reward, error) { return ss.MarkYellow(3, 1) },
+		func() ([]Reward, error) { return ss.MarkYellow(3, 2) },
+	} {
+		if _, err := mark(); err != nil {
+			t.Fatalf("failed to set up sheet: %v", err)
+		}
+	}
+
+	rewards, err := ss.MarkYellow(3, 3)
+	if err != nil {
+		t.Fatalf("MarkYellow: %v", err)
+	}
+	if len(rewards) != 1 || rewards[0] != (Reward{Kind: RewardFox, Source: "yellow row 4"}) {
+		t.Errorf("last box of yellow row 4 unlocked %v, want its fox", rewards)
+	}
+	if ss.Bonus.FoxCount != 1 {
+		t.Errorf("fox count = %d, want 1", ss.Bonus.FoxCount)
+	}
+	// Yellow has no complete column, so it scores 0 and the lowest area is worth nothing
+	lowest := min(ss.AreaTotal(ColorYellow), ss.AreaTotal(ColorGreen), ss.AreaTotal(ColorOrange), ss.AreaTotal(ColorPurple), ss.AreaTotal(ColorBlue))
+	if ss.Bonus.Total != lowest {
+		t.Errorf("bonus = %d, want one fox times the lowest area %d", ss.Bonus.Total, lowest)
+	}
+}
+
+func TestOneMarkCanUnlockSeveralRewards(t *testing.T) {
+	ss := NewScoreSheet()
+	for _, sum := range []int{6, 7, 8, 9} {
+		if _, err := ss.MarkBlue(sum); err != nil {
+			t.Fatalf("MarkBlue(%d): %v", sum, err)
+		}
+	}
+
+	// 5 completes the second row and, with the pre-crossed top-left box, the first column
+	rewards, err := ss.MarkBlue(5)
+	if err != nil {
+		t.Fatalf("MarkBlue: %v", err)
+	}
+	want := []Reward{
+		{Kind: RewardFreeMark, Color: ColorYellow, Source: "blue row 2"},
+		{Kind: RewardReroll, Source: "blue column 1"},
+	}
+	if len(rewards) != len(want) {
+		t.Fatalf("blue 5 unlocked %v, want %v", rewards, want)
+	}
+	for i := range want {
+		if rewards[i] != want[i] {
+			t.Errorf("reward %d = %+v, want %+v", i, rewards[i], want[i])
+		}
+	}
+	if ss.Rerolls.FromMarks != 1 {
+		t.Errorf("rerolls from marks = %d, want the automatic reroll applied", ss.Rerolls.FromMarks)
+	}
+}
+
+func TestTypedFoxesUntilTheFirstMark(t *testing.T) {
+	ss := NewScoreSheet()
+	ss.Bonus.Record(2)
+	if ss.HasMarks() {
+		t.Fatal("empty sheet has marks, though only the printed boxes are crossed")
+	}
+	if ss.Bonus.FoxCount != 2 {
+		t.Errorf("typed fox count = %d, want 2", ss.Bonus.FoxCount)
+	}
+
+	if _, err := ss.MarkGreen(1); err != nil {
+		t.Fatalf("MarkGreen: %v", err)
+	}
+	if !ss.HasMarks() {
+		t.Error("sheet with a green mark has no marks")
+	}
+	if ss.Bonus.FoxCount != 0 {
+		t.Errorf("fox count = %d after the first mark, want the earned 0", ss.Bonus.FoxCount)
+	}
+}
@@ -0,0 +1,165 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotBot is returned when asking a human player to play automatically
+var ErrNotBot = errors.New("player is not a bot")
+
+// Bot strategy IDs
+const (
+	StrategyGreedy     = "greedy"
+	StrategyMonteCarlo = "monte-carlo"
+)
+
+// DefaultRollouts is the number of games a Monte Carlo bot simulates per candidate move
+const DefaultRollouts = 24
+
+// Strategy decides which move a computer player makes
+type Strategy interface {
+	ID() string
+	Name() string
+	// Choose picks one of the moves, or reports false to take none of them.
+	// turn is nil when placing a free mark outside of a dice turn.
+	Choose(player *Player, turn *Turn, moves []Move) (Move, bool)
+}
+
+// StrategyIDs lists the built-in bot strategies
+func StrategyIDs() []string {
+	return []string{StrategyGreedy, StrategyMonteCarlo}
+}
+
+// NewStrategy creates a built-in strategy. roller drives the simulated games of the
+// Monte Carlo strategy and is ignored by the greedy one.
+func NewStrategy(id string, roller Roller) (Strategy, error) {
+	switch id {
+	case StrategyGreedy:
+		return Greedy{}, nil
+	case StrategyMonteCarlo:
+		return NewMonteCarlo(roller, DefaultRollouts), nil
+	default:
+		return nil, fmt.Errorf("unknown bot strategy %q", id)
+	}
+}
+
+// NewBot creates a computer player with an empty sheet for an edition of the game
+func NewBot(name string, strategy Strategy, ruleset Ruleset) *Player {
+	player := NewPlayerWithRuleset(name, ruleset)
+	player.Bot = strategy
+	return player
+}
+
+// PlayBot plays a bot's part of a turn: up to three picks as the active player, or one pick
+// from the silver platter as a passive player. Free marks unlocked on the way are placed
+// where the strategy prefers. It returns the moves made, free marks included.
+func PlayBot(turn *Turn, bot *Player) ([]Move, error) {
+	if !bot.IsBot() {
+		return nil, ErrNotBot
+	}
+
+	var played []Move
+	for turn.canAct(bot) {
+		moves := turn.LegalMoves(bot)
+		if len(moves) == 0 && bot == turn.Active && bot.ScoreSheet.Rerolls.Available() > 0 {
+			if err := turn.Reroll(); err != nil {
+				return played, err
+			}
+			continue
+		}
+
+		move, ok := bot.Bot.Choose(bot, turn, moves)
+		if !ok {
+			return played, turn.Pass(bot)
+		}
+		rewards, err := turn.Pick(bot, move.Placement)
+		if err != nil {
+			return played, err
+		}
+		played = append(played, move)
+
+		free, err := PlaceFreeMarks(bot, turn, rewards)
+		played = append(played, free...)
+		if err != nil {
+			return played, err
+		}
+	}
+	return played, nil
+}
+
+// PlaceFreeMarks lets a bot place the free marks among the rewards, and any free marks those
+// unlock in turn. Automatic rewards are skipped, as the sheet has applied them already.
+func PlaceFreeMarks(bot *Player, turn *Turn, rewards []Reward) ([]Move, error) {
+	if !bot.IsBot() {
+		return nil, ErrNotBot
+	}
+
+	var played []Move
+	pending := append([]Reward(nil), rewards...)
+	for len(pending) > 0 {
+		reward := pending[0]
+		pending = pending[1:]
+		if reward.Automatic() {
+			continue
+		}
+
+		move, ok := bot.Bot.Choose(bot, turn, bot.ScoreSheet.FreeMarkMoves(reward))
+		if !ok {
+			continue
+		}
+		unlocked, err := bot.ScoreSheet.ApplyMove(move)
+		if err != nil {
+			return played, err
+		}
+		played = append(played, move)
+		pending = append(pending, unlocked...)
+	}
+	return played, nil
+}
+
+// PlayBotGame plays a complete game in which every player is a bot, from the first roll
+// to the end of the last round
+func PlayBotGame(players []*Player, roller Roller) error {
+	for _, player := range players {
+		if !player.IsBot() {
+			return fmt.Errorf("%w: %s", ErrNotBot, player.Name)
+		}
+	}
+
+	rounds, err := NewRoundTracker(players)
+	if err != nil {
+		return err
+	}
+	var placeErr error
+	rounds.OnRoundStart = func(_ int, pending []Reward) {
+		for _, player := range players {
+			if _, err := PlaceFreeMarks(player, nil, pending); err != nil && placeErr == nil {
+				placeErr = err
+			}
+		}
+	}
+	rounds.Start()
+
+	for !rounds.Finished {
+		if placeErr != nil {
+			return placeErr
+		}
+		turn, err := rounds.PlayTurn(roller)
+		if err != nil {
+			return err
+		}
+		if _, err := PlayBot(turn, turn.Active); err != nil {
+			return err
+		}
+		for _, player := range turn.Passive {
+			if _, err := PlayBot(turn, player); err != nil {
+				return err
+			}
+		}
+		if _, err := rounds.NextTurn(); err != nil {
+			return err
+		}
+	}
+	return placeErr
+}
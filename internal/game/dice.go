@@ -0,0 +1,197 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ColorWhite is the white die, which may be used as any colour
+const ColorWhite Color = "white"
+
+// ErrWrongDie is returned when a die cannot be entered in the chosen area
+var ErrWrongDie = errors.New("die cannot be used in this area")
+
+// ErrWrongBox is returned when a placement names a box the die value does not fit
+var ErrWrongBox = errors.New("die does not fit this box")
+
+// diceColors are the six dice of the base game, in the order they are rolled
+var diceColors = []Color{ColorWhite, ColorYellow, ColorBlue, ColorGreen, ColorOrange, ColorPurple}
+
+// DiceColors returns the six dice of the base game
+func DiceColors() []Color {
+	return slices.Clone(diceColors)
+}
+
+// Roller is the random source used to roll dice. *rand.Rand from math/rand/v2 satisfies it,
+// so tests and simulations can inject a seeded source.
+type Roller interface {
+	IntN(n int) int
+}
+
+// Dice holds the face value of each die by colour
+type Dice map[Color]int
+
+// Roll rolls the given dice and keeps the values of the others
+func (d Dice) Roll(roller Roller, colors []Color) {
+	for _, color := range colors {
+		d[color] = roller.IntN(6) + 1
+	}
+}
+
+// BlueSum returns the sum of the blue and white dice, which is what the blue area uses
+func (d Dice) BlueSum() int {
+	return d[ColorBlue] + d[ColorWhite]
+}
+
+// Placement enters one die on a score sheet
+type Placement struct {
+	Die  Color // die taken
+	Area Color // area marked, differs from Die when the white die is used as a wildcard
+	Box  int   // yellow: row*YellowSize+col, blue: the dice sum, other areas: the next box index
+}
+
+// YellowPlacement returns the placement of a die on a yellow box
+func YellowPlacement(die Color, row, col int) Placement {
+	return Placement{Die: die, Area: ColorYellow, Box: row*YellowSize + col}
+}
+
+// YellowBox returns the row and column of a yellow placement
+func (p Placement) YellowBox() (row, col int) {
+	return p.Box / YellowSize, p.Box % YellowSize
+}
+
+func (p Placement) String() string {
+	die := AreaName(p.Die)
+	switch {
+	case p.Die == "":
+		die = "Free mark"
+	case p.Die != p.Area:
+		die += " as " + string(p.Area)
+	}
+	switch p.Area {
+	case ColorYellow:
+		row, col := p.YellowBox()
+		return fmt.Sprintf("%s → yellow row %d column %d", die, row+1, col+1)
+	case ColorBlue:
+		return fmt.Sprintf("%s → blue %d", die, p.Box)
+	default:
+		return fmt.Sprintf("%s → %s box %d", die, p.Area, p.Box+1)
+	}
+}
+
+// Value returns the value a placement enters: the die value, or the blue and white sum for blue
+func (p Placement) Value(dice Dice) int {
+	if p.Area == ColorBlue {
+		return dice.BlueSum()
+	}
+	return dice[p.Die]
+}
+
+// CanPlace reports whether a die may be entered on the sheet as described by the placement
+func (ss *ScoreSheet) CanPlace(p Placement, dice Dice) error {
+	if p.Die != p.Area && p.Die != ColorWhite {
+		return fmt.Errorf("%w: %s die in %s", ErrWrongDie, p.Die, p.Area)
+	}
+	value := dice[p.Die]
+	if value < 1 || value > 6 {
+		return ErrInvalidDieValue
+	}
+
+	switch p.Area {
+	case ColorYellow:
+		row, col := p.YellowBox()
+		if p.Box < 0 || !yellowInRange(row, col) {
+			return ErrBoxOutOfRange
+		}
+		if YellowNumber(row, col) != value {
+			return fmt.Errorf("%w: yellow row %d column %d needs a %d", ErrWrongBox, row+1, col+1, YellowNumber(row, col))
+		}
+		if ss.Yellow.IsMarked(row, col) {
+			return ErrAlreadyMarked
+		}
+		return nil
+	case ColorGreen:
+		if p.Box != ss.Green.NextBox() {
+			return fmt.Errorf("%w: the next green box is %d", ErrWrongBox, ss.Green.NextBox()+1)
+		}
+		return ss.Green.CanMark(value)
+	case ColorOrange:
+		if p.Box != ss.Orange.NextSpace() {
+			return fmt.Errorf("%w: the next orange space is %d", ErrWrongBox, ss.Orange.NextSpace()+1)
+		}
+		return ss.Orange.CanMark(value)
+	case ColorPurple:
+		if p.Box != ss.Purple.NextSpace() {
+			return fmt.Errorf("%w: the next purple space is %d", ErrWrongBox, ss.Purple.NextSpace()+1)
+		}
+		return ss.Purple.CanMark(value)
+	case ColorBlue:
+		if p.Box != dice.BlueSum() {
+			return fmt.Errorf("%w: the blue and white dice sum to %d", ErrWrongBox, dice.BlueSum())
+		}
+		return ss.Blue.CanMark(p.Box)
+	default:
+		return fmt.Errorf("%w: %s", ErrWrongDie, p.Area)
+	}
+}
+
+// Place enters a die on the sheet after checking it is legal and returns the rewards it unlocked
+func (ss *ScoreSheet) Place(p Placement, dice Dice) ([]Reward, error) {
+	if err := ss.CanPlace(p, dice); err != nil {
+		return nil, err
+	}
+
+	value := p.Value(dice)
+	switch p.Area {
+	case ColorYellow:
+		return ss.MarkYellow(p.YellowBox())
+	case ColorGreen:
+		return ss.MarkGreen(value)
+	case ColorOrange:
+		return ss.MarkOrange(value)
+	case ColorPurple:
+		return ss.MarkPurple(value)
+	default:
+		return ss.MarkBlue(value)
+	}
+}
+
+// PlaceFreeMark enters a free mark from a reward in the box chosen by the placement.
+// Unlike ApplyFreeMark it also handles yellow and blue crosses and the round 4 six in any colour.
+func (ss *ScoreSheet) PlaceFreeMark(reward Reward, p Placement) ([]Reward, error) {
+	if reward.Kind != RewardFreeMark {
+		return nil, fmt.Errorf("%s is not a free mark", reward)
+	}
+	if reward.Color != "" && reward.Color != p.Area {
+		return nil, fmt.Errorf("%w: %s is for %s", ErrWrongDie, reward, reward.Color)
+	}
+
+	switch p.Area {
+	case ColorYellow:
+		row, col := p.YellowBox()
+		if p.Box < 0 || !yellowInRange(row, col) {
+			return nil, ErrBoxOutOfRange
+		}
+		if reward.Value > 0 && YellowNumber(row, col) != reward.Value {
+			return nil, fmt.Errorf("%w: yellow row %d column %d needs a %d", ErrWrongBox, row+1, col+1, YellowNumber(row, col))
+		}
+		return ss.MarkYellow(row, col)
+	case ColorGreen:
+		if reward.Value > 0 {
+			return ss.MarkGreen(reward.Value)
+		}
+		return ss.recordMark(ss.Green.MarkFree)
+	case ColorOrange:
+		return ss.MarkOrange(reward.Value)
+	case ColorPurple:
+		return ss.MarkPurple(reward.Value)
+	case ColorBlue:
+		if reward.Value > 0 {
+			return nil, fmt.Errorf("%w: a free %d cannot be entered in blue", ErrWrongDie, reward.Value)
+		}
+		return ss.MarkBlue(p.Box)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrWrongDie, p.Area)
+	}
+}
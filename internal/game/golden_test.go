@@ -0,0 +1,96 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// goldenPlayer is the final sheet of one player in a golden game, with its scores spelled out
+type goldenPlayer struct {
+	Name   string        `json:"name"`
+	Areas  map[Color]int `json:"areas"`
+	Foxes  int           `json:"foxes"`
+	Bonus  int           `json:"bonus"`
+	Total  int           `json:"total"`
+	Winner bool          `json:"winner"`
+	Sheet  *ScoreSheet   `json:"sheet"`
+}
+
+// TestGoldenGames plays complete seeded bot games and compares every final sheet and score
+// with the files in testdata/golden. Run with -update after an intended scoring change.
+func TestGoldenGames(t *testing.T) {
+	games := []struct {
+		seed    uint64
+		players int
+	}{
+		{1, 1},
+		{2, 2},
+		{3, 3},
+		{4, 4},
+	}
+
+	for _, g := range games {
+		name := fmt.Sprintf("seed%d-%dp", g.seed, g.players)
+		t.Run(name, func(t *testing.T) {
+			var players []*Player
+			for i := range g.players {
+				players = append(players, NewBot(fmt.Sprintf("Bot %d", i+1), Greedy{}, DefaultRuleset()))
+			}
+			if err := PlayBotGame(players, rand.New(rand.NewPCG(g.seed, 0))); err != nil {
+				t.Fatalf("PlayBotGame: %v", err)
+			}
+
+			winners := Winners(players)
+			var result []goldenPlayer
+			for _, player := range players {
+				ss := player.ScoreSheet
+				areas := make(map[Color]int)
+				for _, area := range ss.Ruleset.Areas() {
+					areas[area] = ss.AreaTotal(area)
+				}
+				result = append(result, goldenPlayer{
+					Name:   player.Name,
+					Areas:  areas,
+					Foxes:  ss.Bonus.FoxCount,
+					Bonus:  ss.Bonus.Total,
+					Total:  ss.GetTotalScore(),
+					Winner: slices.Contains(winners, player),
+					Sheet:  ss,
+				})
+			}
+
+			got, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				t.Fatalf("MarshalIndent: %v", err)
+			}
+			got = append(got, '\n')
+
+			path := filepath.Join("testdata", "golden", name+".json")
+			if *updateGolden {
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatalf("failed to create golden directory: %v", err)
+				}
+				if err := os.WriteFile(path, got, 0o644); err != nil {
+					t.Fatalf("failed to write golden file: %v", err)
+				}
+			}
+
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read golden file (run with -update to create it): %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("game differs from %s; run with -update if the change is intended\ngot:\n%s", path, got)
+			}
+		})
+	}
+}
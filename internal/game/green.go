@@ -0,0 +1,101 @@
+package game
+
+import "fmt"
+
+// GreenSize is the number of boxes on the green track
+const GreenSize = 11
+
+// greenThresholds is the minimum die value printed in each green box, left to right
+var greenThresholds = [GreenSize]int{1, 2, 3, 4, 5, 1, 2, 3, 4, 5, 6}
+
+// greenPoints maps the number of filled green boxes to points
+var greenPoints = [GreenSize + 1]int{0, 1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 66}
+
+type GreenScoreArea struct {
+	Total  int
+	Values []int // die values entered left to right, at most GreenSize, 0 for a free cross
+}
+
+// NewGreenScoreArea creates an empty green track
+func NewGreenScoreArea() *GreenScoreArea {
+	return &GreenScoreArea{
+		Values: make([]int, 0, GreenSize),
+	}
+}
+
+func (gsa *GreenScoreArea) Record(score int) {
+	gsa.Total = score
+}
+
+// GreenThreshold returns the minimum die value required for a green box
+func GreenThreshold(box int) int {
+	if box < 0 || box >= GreenSize {
+		return 0
+	}
+	return greenThresholds[box]
+}
+
+// GreenPoints returns the points for a number of filled green boxes
+func GreenPoints(count int) int {
+	if count < 0 {
+		return 0
+	}
+	if count > GreenSize {
+		count = GreenSize
+	}
+	return greenPoints[count]
+}
+
+// NextBox returns the index of the next green box to fill, or GreenSize when full
+func (gsa *GreenScoreArea) NextBox() int {
+	return len(gsa.Values)
+}
+
+// CanMark reports whether a die value may be entered in the next green box
+func (gsa *GreenScoreArea) CanMark(value int) error {
+	if value < 1 || value > 6 {
+		return ErrInvalidDieValue
+	}
+	box := gsa.NextBox()
+	if box >= GreenSize {
+		return ErrAreaFull
+	}
+	if value < greenThresholds[box] {
+		return fmt.Errorf("%w: green box %d needs %d or more", ErrValueTooLow, box+1, greenThresholds[box])
+	}
+	return nil
+}
+
+// Mark enters a die value in the next green box and updates the total
+func (gsa *GreenScoreArea) Mark(value int) error {
+	if err := gsa.CanMark(value); err != nil {
+		return err
+	}
+	gsa.Values = append(gsa.Values, value)
+	gsa.Total = calculateGreenScore(gsa)
+	return nil
+}
+
+// MarkFree crosses off the next green box without a die, as granted by a bonus
+func (gsa *GreenScoreArea) MarkFree() error {
+	if gsa.NextBox() >= GreenSize {
+		return ErrAreaFull
+	}
+	gsa.Values = append(gsa.Values, 0)
+	gsa.Total = calculateGreenScore(gsa)
+	return nil
+}
+
+// Unmark removes the last green entry and updates the total
+func (gsa *GreenScoreArea) Unmark() error {
+	if len(gsa.Values) == 0 {
+		return ErrAreaEmpty
+	}
+	gsa.Values = gsa.Values[:len(gsa.Values)-1]
+	gsa.Total = calculateGreenScore(gsa)
+	return nil
+}
+
+func calculateGreenScore(green *GreenScoreArea) int {
+	return GreenPoints(len(green.Values))
+}
@@ -0,0 +1,154 @@
+package game
+
+import "errors"
+
+// ErrNothingToUndo is returned when the undo log is empty
+var ErrNothingToUndo = errors.New("nothing to undo")
+
+// ErrNothingToRedo is returned when there is no undone change to reapply
+var ErrNothingToRedo = errors.New("nothing to redo")
+
+// Change is one recorded mutation of a player's score sheet
+type Change struct {
+	Player      *Player
+	Description string
+	Before      *ScoreSheet
+	After       *ScoreSheet
+	mergeable   bool
+}
+
+// History is an undo/redo log of score sheet mutations across all players
+type History struct {
+	undo []*Change
+	redo []*Change
+}
+
+// NewHistory creates an empty history
+func NewHistory() *History {
+	return &History{}
+}
+
+// Record applies a mutation to a player's sheet and logs it for undo.
+// Nothing is logged when the mutation fails.
+func (h *History) Record(player *Player, description string, mutate func() error) error {
+	return h.record(player, description, false, mutate)
+}
+
+// RecordEdit is like Record but merges consecutive edits with the same description,
+// so typing a multi-digit total is undone in a single step
+func (h *History) RecordEdit(player *Player, description string, mutate func() error) error {
+	return h.record(player, description, true, mutate)
+}
+
+func (h *History) record(player *Player, description string, mergeable bool, mutate func() error) error {
+	before := player.ScoreSheet.Clone()
+	if err := mutate(); err != nil {
+		return err
+	}
+	after := player.ScoreSheet.Clone()
+
+	if mergeable && len(h.undo) > 0 {
+		last := h.undo[len(h.undo)-1]
+		if last.mergeable && last.Player == player && last.Description == description {
+			last.After = after
+			h.redo = nil
+			return nil
+		}
+	}
+
+	h.undo = append(h.undo, &Change{
+		Player:      player,
+		Description: description,
+		Before:      before,
+		After:       after,
+		mergeable:   mergeable,
+	})
+	h.redo = nil
+	return nil
+}
+
+// CanUndo reports whether there is a change to undo
+func (h *History) CanUndo() bool {
+	return len(h.undo) > 0
+}
+
+// CanRedo reports whether there is an undone change to reapply
+func (h *History) CanRedo() bool {
+	return len(h.redo) > 0
+}
+
+// Undo restores the sheet from before the most recent change
+func (h *History) Undo() (*Change, error) {
+	if len(h.undo) == 0 {
+		return nil, ErrNothingToUndo
+	}
+	change := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	change.Player.ScoreSheet.Restore(change.Before)
+	change.mergeable = false
+	h.redo = append(h.redo, change)
+	return change, nil
+}
+
+// Redo reapplies the most recently undone change
+func (h *History) Redo() (*Change, error) {
+	if len(h.redo) == 0 {
+		return nil, ErrNothingToRedo
+	}
+	change := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	change.Player.ScoreSheet.Restore(change.After)
+	h.undo = append(h.undo, change)
+	return change, nil
+}
+
+// Clone returns a deep copy of the score sheet
+func (ss *ScoreSheet) Clone() *ScoreSheet {
+	clone := NewScoreSheetFor(ss.Ruleset)
+	clone.copyFrom(ss)
+	clone.Round = ss.Round
+	clone.Rerolls.FromRounds = ss.Rerolls.FromRounds
+	clone.PlusOnes.FromRounds = ss.PlusOnes.FromRounds
+	return clone
+}
+
+// Restore copies a saved sheet back into this one, keeping the area pointers held by
+// the UI valid. Round progress and round rewards are left alone, since they belong to
+// the round tracker rather than to the player's edits.
+func (ss *ScoreSheet) Restore(saved *ScoreSheet) {
+	ss.copyFrom(saved)
+}
+
+func (ss *ScoreSheet) copyFrom(other *ScoreSheet) {
+	*ss.Yellow = *other.Yellow
+
+	ss.Green.Total = other.Green.Total
+	ss.Green.Values = append(ss.Green.Values[:0], other.Green.Values...)
+
+	ss.Orange.Total = other.Orange.Total
+	ss.Orange.Values = append(ss.Orange.Values[:0], other.Orange.Values...)
+
+	ss.Purple.Total = other.Purple.Total
+	ss.Purple.Values = append(ss.Purple.Values[:0], other.Purple.Values...)
+
+	*ss.Blue = *other.Blue
+	*ss.Bonus = *other.Bonus
+
+	copyActions := func(dst, src *ActionTrack) {
+		dst.FromMarks = src.FromMarks
+		dst.Granted = src.Granted
+		dst.Used = src.Used
+	}
+	copyActions(ss.Rerolls, other.Rerolls)
+	copyActions(ss.PlusOnes, other.PlusOnes)
+
+	// Typed totals are copied into the existing areas, which the UI keeps pointers to
+	for area, ta := range ss.Totals {
+		if _, ok := other.Totals[area]; !ok {
+			ta.Total = 0
+		}
+	}
+	for area, ta := range other.Totals {
+		ss.totalArea(area).Total = ta.Total
+	}
+}
@@ -0,0 +1,152 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestUndoKeepsTotalAreas holds a typed-total area across an undo and a redo, the way the
+// calculator does, and checks that recording into it still changes the sheet
+func TestUndoKeepsTotalAreas(t *testing.T) {
+	player := NewPlayerWithRuleset("Alice", RulesetByID(RulesetDoppeltSoClever))
+	sheet := player.ScoreSheet
+	silver := sheet.Area(ColorSilver)
+	history := NewHistory()
+
+	if err := history.Record(player, "silver", func() error { silver.Record(12); return nil }); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	if _, err := history.Undo(); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if got := sheet.AreaTotal(ColorSilver); got != 0 {
+		t.Fatalf("silver after undo = %d, want 0", got)
+	}
+
+	silver.Record(25)
+	if got := sheet.AreaTotal(ColorSilver); got != 25 {
+		t.Fatalf("silver after recording into the held area = %d, want 25", got)
+	}
+
+	if err := history.Record(player, "pink", func() error { sheet.Area(ColorPink).Record(8); return nil }); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	if _, err := history.Undo(); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if _, err := history.Redo(); err != nil {
+		t.Fatalf("Redo: %v", err)
+	}
+	silver.Record(30)
+	if got := sheet.AreaTotal(ColorSilver); got != 30 {
+		t.Errorf("silver after redo = %d, want 30", got)
+	}
+	if got := sheet.AreaTotal(ColorPink); got != 8 {
+		t.Errorf("pink after redo = %d, want 8", got)
+	}
+}
+
+func TestUndoAndRedoMarks(t *testing.T) {
+	player := NewPlayer("Alice")
+	sheet := player.ScoreSheet
+	history := NewHistory()
+	mark := func(description string, mark func() ([]Reward, error)) {
+		t.Helper()
+		err := history.Record(player, description, func() error {
+			_, err := mark()
+			return err
+		})
+		if err != nil {
+			t.Fatalf("Record(%s): %v", description, err)
+		}
+	}
+
+	for _, value := range []int{2, 3} {
+		mark("orange", func() ([]Reward, error) { return sheet.MarkOrange(value) })
+	}
+	mark("orange", func() ([]Reward, error) { return sheet.MarkOrange(4) }) // third orange earns a reroll
+	mark("yellow", func() ([]Reward, error) { return sheet.MarkYellow(0, 0) })
+	total := sheet.GetTotalScore()
+
+	change, err := history.Undo()
+	if err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if change.Description != "yellow" || sheet.Yellow.IsMarked(0, 0) {
+		t.Errorf("undid %q, yellow marked %v, want the yellow mark taken back", change.Description, sheet.Yellow.IsMarked(0, 0))
+	}
+	if _, err := history.Undo(); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if len(sheet.Orange.Values) != 2 || sheet.Rerolls.FromMarks != 0 {
+		t.Errorf("orange %v with %d rerolls after undo, want two values and the reroll taken back", sheet.Orange.Values, sheet.Rerolls.FromMarks)
+	}
+
+	for range 2 {
+		if _, err := history.Redo(); err != nil {
+			t.Fatalf("Redo: %v", err)
+		}
+	}
+	if sheet.GetTotalScore() != total || !sheet.Yellow.IsMarked(0, 0) || sheet.Rerolls.FromMarks != 1 {
+		t.Errorf("total %d, yellow %v, rerolls %d after redo, want %d, marked and 1",
+			sheet.GetTotalScore(), sheet.Yellow.IsMarked(0, 0), sheet.Rerolls.FromMarks, total)
+	}
+	if _, err := history.Redo(); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("Redo with nothing undone: error = %v, want ErrNothingToRedo", err)
+	}
+
+	// A new change after an undo drops the undone one
+	if _, err := history.Undo(); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	mark("green", func() ([]Reward, error) { return sheet.MarkGreen(1) })
+	if history.CanRedo() {
+		t.Error("undone yellow mark can still be redone after a new change")
+	}
+
+	for history.CanUndo() {
+		if _, err := history.Undo(); err != nil {
+			t.Fatalf("Undo: %v", err)
+		}
+	}
+	if sheet.GetTotalScore() != 0 || sheet.HasMarks() {
+		t.Errorf("sheet after undoing everything: total %d, has marks %v, want empty", sheet.GetTotalScore(), sheet.HasMarks())
+	}
+	if _, err := history.Undo(); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("Undo with nothing recorded: error = %v, want ErrNothingToUndo", err)
+	}
+}
+
+func TestFailedMarkIsNotRecorded(t *testing.T) {
+	player := NewPlayer("Alice")
+	history := NewHistory()
+	err := history.Record(player, "purple", func() error {
+		_, err := player.ScoreSheet.MarkPurple(7)
+		return err
+	})
+	if !errors.Is(err, ErrInvalidDieValue) {
+		t.Fatalf("Record error = %v, want ErrInvalidDieValue", err)
+	}
+	if history.CanUndo() {
+		t.Error("failed mark was recorded for undo")
+	}
+}
+
+func TestTypedEditsUndoInOneStep(t *testing.T) {
+	player := NewPlayer("Alice")
+	green := player.ScoreSheet.Area(ColorGreen)
+	history := NewHistory()
+
+	// Typing 1 then 15 into the green total is one edit
+	for _, total := range []int{1, 15} {
+		if err := history.RecordEdit(player, "green total", func() error { green.Record(total); return nil }); err != nil {
+			t.Fatalf("RecordEdit: %v", err)
+		}
+	}
+	if _, err := history.Undo(); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if got := player.ScoreSheet.AreaTotal(ColorGreen); got != 0 || history.CanUndo() {
+		t.Errorf("green = %d after one undo, can undo %v, want 0 and nothing left", got, history.CanUndo())
+	}
+}
@@ -0,0 +1,257 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// JSONVersion is the version of the JSON format written for sheets, players and games.
+// Bump it when the format changes and keep reading the older versions.
+const JSONVersion = 1
+
+// ErrUnsupportedVersion is returned when decoding JSON written by a newer version of the app
+var ErrUnsupportedVersion = errors.New("unsupported JSON format version")
+
+// GameState is everything needed to save and resume a game in progress
+type GameState struct {
+	Ruleset Ruleset
+	Players []*Player
+	Rounds  *RoundTracker // nil when rounds are not tracked
+}
+
+type sheetJSON struct {
+	Version int    `json:"version"`
+	Ruleset string `json:"ruleset"`
+
+	// Totals holds the score of every area, typed or derived from the marks
+	Totals map[Color]int `json:"totals"`
+
+	Yellow [YellowSize][YellowSize]bool `json:"yellow"`
+	Green  []int                        `json:"green"`
+	Orange []int                        `json:"orange"`
+	Purple []int                        `json:"purple"`
+	Blue   []int                        `json:"blue"` // crossed dice sums
+
+	Foxes    int             `json:"foxes"`
+	Bonus    int             `json:"bonus"`
+	Rerolls  actionTrackJSON `json:"rerolls"`
+	PlusOnes actionTrackJSON `json:"plusOnes"`
+	Round    int             `json:"round"`
+}
+
+type actionTrackJSON struct {
+	FromMarks  int `json:"fromMarks"`
+	FromRounds int `json:"fromRounds"`
+	Granted    int `json:"granted"`
+	Used       int `json:"used"`
+}
+
+type playerJSON struct {
+	Version int         `json:"version"`
+	Name    string      `json:"name"`
+	Bot     string      `json:"bot,omitempty"` // strategy ID, empty for a human player
+	Active  bool        `json:"active"`
+	Sheet   *ScoreSheet `json:"sheet"`
+}
+
+type gameJSON struct {
+	Version int         `json:"version"`
+	Ruleset string      `json:"ruleset"`
+	Players []*Player   `json:"players"`
+	Rounds  *roundsJSON `json:"rounds,omitempty"`
+}
+
+type roundsJSON struct {
+	Round       int  `json:"round"`
+	TotalRounds int  `json:"totalRounds"`
+	ActiveIndex int  `json:"activeIndex"`
+	Finished    bool `json:"finished"`
+}
+
+// MarshalJSON encodes the sheet with its edition, marks, area totals and action tracks
+func (ss *ScoreSheet) MarshalJSON() ([]byte, error) {
+	out := sheetJSON{
+		Version:  JSONVersion,
+		Ruleset:  ss.Ruleset.ID(),
+		Totals:   make(map[Color]int),
+		Yellow:   ss.Yellow.Marks,
+		Green:    slices.Clone(ss.Green.Values),
+		Orange:   slices.Clone(ss.Orange.Values),
+		Purple:   slices.Clone(ss.Purple.Values),
+		Blue:     []int{},
+		Foxes:    ss.Bonus.FoxCount,
+		Bonus:    ss.Bonus.Total,
+		Rerolls:  actionTrackToJSON(ss.Rerolls),
+		PlusOnes: actionTrackToJSON(ss.PlusOnes),
+		Round:    ss.Round,
+	}
+	for _, area := range ss.Ruleset.Areas() {
+		out.Totals[area] = ss.AreaTotal(area)
+	}
+	for sum := BlueMinSum; sum <= BlueMaxSum; sum++ {
+		if ss.Blue.Marks[sum] {
+			out.Blue = append(out.Blue, sum)
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON replaces the sheet with one decoded from JSON written by MarshalJSON
+func (ss *ScoreSheet) UnmarshalJSON(data []byte) error {
+	var in sheetJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := checkJSONVersion(in.Version); err != nil {
+		return err
+	}
+
+	if len(in.Green) > GreenSize || len(in.Orange) > OrangeSize || len(in.Purple) > PurpleSize {
+		return ErrAreaFull
+	}
+
+	sheet := NewScoreSheetFor(RulesetByID(in.Ruleset))
+	sheet.Yellow.Marks = in.Yellow
+	sheet.Green.Values = append(sheet.Green.Values, in.Green...)
+	sheet.Orange.Values = append(sheet.Orange.Values, in.Orange...)
+	sheet.Purple.Values = append(sheet.Purple.Values, in.Purple...)
+	for _, sum := range in.Blue {
+		if sum < BlueMinSum || sum > BlueMaxSum {
+			return fmt.Errorf("%w: blue %d", ErrBoxOutOfRange, sum)
+		}
+		sheet.Blue.Marks[sum] = true
+	}
+
+	// Totals are restored as saved rather than recalculated, so typed totals survive
+	for area, total := range in.Totals {
+		if scoreArea := sheet.Area(area); scoreArea != nil {
+			scoreArea.Record(total)
+		}
+	}
+	sheet.Bonus.FoxCount = in.Foxes
+	sheet.Bonus.Total = in.Bonus
+	in.Rerolls.restore(sheet.Rerolls)
+	in.PlusOnes.restore(sheet.PlusOnes)
+	sheet.Round = in.Round
+
+	*ss = *sheet
+	return nil
+}
+
+// MarshalJSON encodes the player with their sheet and, for a bot, its strategy
+func (p *Player) MarshalJSON() ([]byte, error) {
+	out := playerJSON{
+		Version: JSONVersion,
+		Name:    p.Name,
+		Active:  p.IsActive,
+		Sheet:   p.ScoreSheet,
+	}
+	if p.Bot != nil {
+		out.Bot = p.Bot.ID()
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON replaces the player with one decoded from JSON written by MarshalJSON.
+// A bot gets a fresh instance of its strategy with its own random source.
+func (p *Player) UnmarshalJSON(data []byte) error {
+	var in playerJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := checkJSONVersion(in.Version); err != nil {
+		return err
+	}
+	if in.Sheet == nil {
+		return fmt.Errorf("player %q has no score sheet", in.Name)
+	}
+
+	var bot Strategy
+	if in.Bot != "" {
+		strategy, err := NewStrategy(in.Bot, rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())))
+		if err != nil {
+			return err
+		}
+		bot = strategy
+	}
+
+	*p = Player{Name: in.Name, ScoreSheet: in.Sheet, IsActive: in.Active, Bot: bot}
+	return nil
+}
+
+// MarshalJSON encodes the edition, the players and where the round tracker stands
+func (gs *GameState) MarshalJSON() ([]byte, error) {
+	out := gameJSON{
+		Version: JSONVersion,
+		Ruleset: gs.Ruleset.ID(),
+		Players: gs.Players,
+	}
+	if gs.Rounds != nil {
+		out.Rounds = &roundsJSON{
+			Round:       gs.Rounds.Round,
+			TotalRounds: gs.Rounds.TotalRounds,
+			ActiveIndex: gs.Rounds.ActiveIndex,
+			Finished:    gs.Rounds.Finished,
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON replaces the game with one decoded from JSON written by MarshalJSON.
+// The round tracker is restored where it stood, without granting round rewards again.
+func (gs *GameState) UnmarshalJSON(data []byte) error {
+	var in gameJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := checkJSONVersion(in.Version); err != nil {
+		return err
+	}
+
+	ruleset := RulesetByID(in.Ruleset)
+	for _, player := range in.Players {
+		if player == nil || player.ScoreSheet.Ruleset.ID() != ruleset.ID() {
+			return fmt.Errorf("player sheets do not match the %s edition", ruleset.Name())
+		}
+	}
+
+	state := GameState{Ruleset: ruleset, Players: in.Players}
+	if in.Rounds != nil {
+		rounds, err := NewRoundTracker(in.Players)
+		if err != nil {
+			return err
+		}
+		if in.Rounds.ActiveIndex < 0 || in.Rounds.ActiveIndex >= len(in.Players) {
+			return fmt.Errorf("active player %d is not in the game", in.Rounds.ActiveIndex)
+		}
+		rounds.Round = in.Rounds.Round
+		rounds.TotalRounds = in.Rounds.TotalRounds
+		rounds.ActiveIndex = in.Rounds.ActiveIndex
+		rounds.Finished = in.Rounds.Finished
+		state.Rounds = rounds
+	}
+
+	*gs = state
+	return nil
+}
+
+func actionTrackToJSON(at *ActionTrack) actionTrackJSON {
+	return actionTrackJSON{FromMarks: at.FromMarks, FromRounds: at.FromRounds, Granted: at.Granted, Used: at.Used}
+}
+
+func (in actionTrackJSON) restore(at *ActionTrack) {
+	at.FromMarks = in.FromMarks
+	at.FromRounds = in.FromRounds
+	at.Granted = in.Granted
+	at.Used = in.Used
+}
+
+func checkJSONVersion(version int) error {
+	if version < 1 || version > JSONVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	return nil
+}
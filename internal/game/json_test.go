@@ -0,0 +1,199 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// playedBot returns a greedy bot that has played a complete seeded game
+func playedBot(t *testing.T, seed uint64) *Player {
+	t.Helper()
+	bot := NewBot("Bot", Greedy{}, DefaultRuleset())
+	if err := PlayBotGame([]*Player{bot}, rand.New(rand.NewPCG(seed, 0))); err != nil {
+		t.Fatalf("PlayBotGame: %v", err)
+	}
+	return bot
+}
+
+func TestScoreSheetJSONRoundTrip(t *testing.T) {
+	for seed := range uint64(5) {
+		sheet := playedBot(t, seed).ScoreSheet
+		sheet.Rerolls.Grant()
+
+		data, err := json.Marshal(sheet)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		var decoded ScoreSheet
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+
+		if !reflect.DeepEqual(sheet, &decoded) {
+			t.Errorf("seed %d: decoded sheet differs\nsaved:   %s", seed, data)
+		}
+		if got, want := decoded.GetTotalScore(), sheet.GetTotalScore(); got != want {
+			t.Errorf("seed %d: total = %d, want %d", seed, got, want)
+		}
+	}
+}
+
+func TestScoreSheetJSONKeepsTypedTotals(t *testing.T) {
+	for _, ruleset := range Rulesets() {
+		t.Run(ruleset.ID(), func(t *testing.T) {
+			sheet := NewScoreSheetFor(ruleset)
+			for i, area := range ruleset.Areas() {
+				sheet.Area(area).Record(10 + i)
+			}
+			sheet.Bonus.Record(2)
+			sheet.CalculateBonus()
+
+			data, err := json.Marshal(sheet)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+			var decoded ScoreSheet
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+
+			if decoded.Ruleset.ID() != ruleset.ID() {
+				t.Errorf("ruleset = %s, want %s", decoded.Ruleset.ID(), ruleset.ID())
+			}
+			for _, area := range ruleset.Areas() {
+				if got, want := decoded.AreaTotal(area), sheet.AreaTotal(area); got != want {
+					t.Errorf("%s total = %d, want %d", area, got, want)
+				}
+			}
+			if got, want := decoded.GetTotalScore(), sheet.GetTotalScore(); got != want {
+				t.Errorf("total = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestPlayerJSONRoundTrip(t *testing.T) {
+	players := []*Player{
+		NewPlayer("Alice"),
+		NewBot("Robo", NewMonteCarlo(rand.New(rand.NewPCG(1, 2)), DefaultRollouts), DefaultRuleset()),
+	}
+	players[0].IsActive = true
+
+	for _, player := range players {
+		data, err := json.Marshal(player)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		var decoded Player
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal: %v", err)
+		}
+
+		if decoded.Name != player.Name || decoded.IsActive != player.IsActive {
+			t.Errorf("decoded %+v, want %+v", decoded, *player)
+		}
+		if decoded.IsBot() != player.IsBot() {
+			t.Fatalf("%s: IsBot = %v, want %v", player.Name, decoded.IsBot(), player.IsBot())
+		}
+		if player.IsBot() && decoded.Bot.ID() != player.Bot.ID() {
+			t.Errorf("%s: strategy = %s, want %s", player.Name, decoded.Bot.ID(), player.Bot.ID())
+		}
+		if !reflect.DeepEqual(decoded.ScoreSheet, player.ScoreSheet) {
+			t.Errorf("%s: decoded sheet differs", player.Name)
+		}
+	}
+}
+
+func TestGameStateJSONRoundTrip(t *testing.T) {
+	roller := rand.New(rand.NewPCG(7, 0))
+	players := []*Player{
+		NewBot("Ann", Greedy{}, DefaultRuleset()),
+		NewBot("Ben", Greedy{}, DefaultRuleset()),
+	}
+	rounds, err := NewRoundTracker(players)
+	if err != nil {
+		t.Fatalf("NewRoundTracker: %v", err)
+	}
+	rounds.Start()
+
+	// Play into the second round so the tracker and sheets are part way through the game
+	for range 3 {
+		turn, err := rounds.PlayTurn(roller)
+		if err != nil {
+			t.Fatalf("PlayTurn: %v", err)
+		}
+		for _, player := range players {
+			if _, err := PlayBot(turn, player); err != nil {
+				t.Fatalf("PlayBot: %v", err)
+			}
+		}
+		if _, err := rounds.NextTurn(); err != nil {
+			t.Fatalf("NextTurn: %v", err)
+		}
+	}
+
+	state := &GameState{Ruleset: DefaultRuleset(), Players: players, Rounds: rounds}
+	data, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var decoded GameState
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if decoded.Ruleset.ID() != state.Ruleset.ID() {
+		t.Errorf("ruleset = %s, want %s", decoded.Ruleset.ID(), state.Ruleset.ID())
+	}
+	if len(decoded.Players) != len(players) {
+		t.Fatalf("players = %d, want %d", len(decoded.Players), len(players))
+	}
+	for i, player := range decoded.Players {
+		if player.Name != players[i].Name || !reflect.DeepEqual(player.ScoreSheet, players[i].ScoreSheet) {
+			t.Errorf("player %d differs after round trip", i)
+		}
+	}
+	if decoded.Rounds == nil {
+		t.Fatal("round tracker was not restored")
+	}
+	if decoded.Rounds.Round != 2 || decoded.Rounds.ActiveIndex != 1 || decoded.Rounds.Finished {
+		t.Errorf("rounds = round %d active %d finished %v, want round 2 active 1",
+			decoded.Rounds.Round, decoded.Rounds.ActiveIndex, decoded.Rounds.Finished)
+	}
+	if decoded.Rounds.ActivePlayer() != decoded.Players[1] {
+		t.Errorf("active player is not the restored player")
+	}
+
+	// The restored tracker plays on to the end of the game
+	for !decoded.Rounds.Finished {
+		turn, err := decoded.Rounds.PlayTurn(roller)
+		if err != nil {
+			t.Fatalf("PlayTurn after restore: %v", err)
+		}
+		for _, player := range decoded.Players {
+			if _, err := PlayBot(turn, player); err != nil {
+				t.Fatalf("PlayBot after restore: %v", err)
+			}
+		}
+		if _, err := decoded.Rounds.NextTurn(); err != nil {
+			t.Fatalf("NextTurn after restore: %v", err)
+		}
+	}
+}
+
+func TestJSONRejectsNewerVersion(t *testing.T) {
+	data, err := json.Marshal(NewScoreSheet())
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	newer := strings.Replace(string(data), `"version":1`, `"version":99`, 1)
+
+	var sheet ScoreSheet
+	if err := json.Unmarshal([]byte(newer), &sheet); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Unmarshal newer version: err = %v, want ErrUnsupportedVersion", err)
+	}
+}
@@ -0,0 +1,151 @@
+package game
+
+import "maps"
+
+// Move is a legal placement together with what it would do to the sheet
+type Move struct {
+	Placement
+	Value   int      // value entered: the die value, or the blue and white sum for blue
+	Points  int      // change in total score, including the fox bonus
+	Rewards []Reward // rewards the placement unlocks
+	Free    *Reward  // free mark being placed, nil when a die is entered
+
+	dice Dice // roll the move was generated for
+}
+
+// LegalMoves lists every legal placement of the available dice on the sheet. A coloured die
+// may only be entered in its own area, the white die in any area. Each move carries the
+// points and rewards it would earn, worked out on a copy of the sheet.
+func (ss *ScoreSheet) LegalMoves(dice Dice, available []Color) []Move {
+	if !ss.Ruleset.MarkLevel() {
+		return nil
+	}
+
+	dice = maps.Clone(dice)
+	var moves []Move
+	for _, die := range available {
+		for _, placement := range ss.candidatePlacements(die, dice) {
+			if ss.CanPlace(placement, dice) != nil {
+				continue
+			}
+			move := Move{Placement: placement, Value: placement.Value(dice), dice: dice}
+			if ss.evaluate(&move) == nil {
+				moves = append(moves, move)
+			}
+		}
+	}
+	return moves
+}
+
+// FreeMarkMoves lists every box a free mark may be placed in, with what each would earn.
+// A free mark without a colour, like the round 4 six, may go in any area that takes a die value.
+func (ss *ScoreSheet) FreeMarkMoves(reward Reward) []Move {
+	if reward.Kind != RewardFreeMark || !ss.Ruleset.MarkLevel() {
+		return nil
+	}
+
+	areas := []Color{reward.Color}
+	if reward.Color == "" {
+		areas = ss.Ruleset.Areas()
+	}
+
+	var moves []Move
+	for _, area := range areas {
+		for _, placement := range ss.freePlacements(reward, area) {
+			move := Move{Placement: placement, Value: reward.Value, Free: &reward}
+			if area == ColorBlue {
+				move.Value = placement.Box
+			}
+			if ss.evaluate(&move) == nil {
+				moves = append(moves, move)
+			}
+		}
+	}
+	return moves
+}
+
+// ApplyMove makes a move generated by LegalMoves or FreeMarkMoves on the sheet
+func (ss *ScoreSheet) ApplyMove(m Move) ([]Reward, error) {
+	if m.Free != nil {
+		return ss.PlaceFreeMark(*m.Free, m.Placement)
+	}
+	return ss.Place(m.Placement, m.dice)
+}
+
+// evaluate fills in the points and rewards of a move by making it on a copy of the sheet
+func (ss *ScoreSheet) evaluate(m *Move) error {
+	trial := ss.Clone()
+	rewards, err := trial.ApplyMove(*m)
+	if err != nil {
+		return err
+	}
+	m.Points = trial.GetTotalScore() - ss.GetTotalScore()
+	m.Rewards = rewards
+	return nil
+}
+
+// LegalMoves lists the placements a player may choose from right now in the turn
+func (t *Turn) LegalMoves(player *Player) []Move {
+	return player.ScoreSheet.LegalMoves(t.Dice, t.Available(player))
+}
+
+// candidatePlacements returns the boxes a die could go in, before checking the sheet
+func (ss *ScoreSheet) candidatePlacements(die Color, dice Dice) []Placement {
+	areas := []Color{die}
+	if die == ColorWhite {
+		areas = ss.Ruleset.Areas()
+	}
+
+	var placements []Placement
+	for _, area := range areas {
+		switch area {
+		case ColorYellow:
+			for row := range YellowSize {
+				for col := range YellowSize {
+					if YellowNumber(row, col) == dice[die] {
+						placements = append(placements, YellowPlacement(die, row, col))
+					}
+				}
+			}
+		case ColorGreen:
+			placements = append(placements, Placement{Die: die, Area: area, Box: ss.Green.NextBox()})
+		case ColorOrange:
+			placements = append(placements, Placement{Die: die, Area: area, Box: ss.Orange.NextSpace()})
+		case ColorPurple:
+			placements = append(placements, Placement{Die: die, Area: area, Box: ss.Purple.NextSpace()})
+		case ColorBlue:
+			placements = append(placements, Placement{Die: die, Area: area, Box: dice.BlueSum()})
+		}
+	}
+	return placements
+}
+
+// freePlacements returns the boxes of an area a free mark could go in, before checking the sheet
+func (ss *ScoreSheet) freePlacements(reward Reward, area Color) []Placement {
+	var placements []Placement
+	switch area {
+	case ColorYellow:
+		for row := range YellowSize {
+			for col := range YellowSize {
+				if reward.Value == 0 || YellowNumber(row, col) == reward.Value {
+					placements = append(placements, YellowPlacement("", row, col))
+				}
+			}
+		}
+	case ColorGreen:
+		placements = append(placements, Placement{Area: area, Box: ss.Green.NextBox()})
+	case ColorOrange:
+		placements = append(placements, Placement{Area: area, Box: ss.Orange.NextSpace()})
+	case ColorPurple:
+		placements = append(placements, Placement{Area: area, Box: ss.Purple.NextSpace()})
+	case ColorBlue:
+		// Blue boxes are indexed by a dice sum, so a free blue cross may go in any box
+		// but a free die value cannot be entered there
+		if reward.Value == 0 {
+			for sum := BlueMinSum; sum <= BlueMaxSum; sum++ {
+				placements = append(placements, Placement{Area: area, Box: sum})
+			}
+		}
+	}
+	return placements
+}
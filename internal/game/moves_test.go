@@ -0,0 +1,211 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// sheetWith returns an empty sheet after applying the marks, failing the test on an error
+func sheetWith(t *testing.T, marks ...func(ss *ScoreSheet) ([]Reward, error)) *ScoreSheet {
+	t.Helper()
+	ss := NewPlayer("Test").ScoreSheet
+	for _, mark := range marks {
+		if _, err := mark(ss); err != nil {
+			t.Fatalf("failed to set up sheet: %v", err)
+		}
+	}
+	return ss
+}
+
+func greens(values ...int) func(ss *ScoreSheet) ([]Reward, error) {
+	return func(ss *ScoreSheet) ([]Reward, error) {
+		for _, value := range values {
+			if _, err := ss.MarkGreen(value); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+}
+
+func purples(values ...int) func(ss *ScoreSheet) ([]Reward, error) {
+	return func(ss *ScoreSheet) ([]Reward, error) {
+		for _, value := range values {
+			if _, err := ss.MarkPurple(value); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+}
+
+func oranges(values ...int) func(ss *ScoreSheet) ([]Reward, error) {
+	return func(ss *ScoreSheet) ([]Reward, error) {
+		for _, value := range values {
+			if _, err := ss.MarkOrange(value); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+}
+
+func yellow(row, col int) func(ss *ScoreSheet) ([]Reward, error) {
+	return func(ss *ScoreSheet) ([]Reward, error) { return ss.MarkYellow(row, col) }
+}
+
+func blue(sum int) func(ss *ScoreSheet) ([]Reward, error) {
+	return func(ss *ScoreSheet) ([]Reward, error) { return ss.MarkBlue(sum) }
+}
+
+func TestCanPlace(t *testing.T) {
+	tests := []struct {
+		name    string
+		marks   []func(ss *ScoreSheet) ([]Reward, error)
+		p       Placement
+		dice    Dice
+		wantErr error
+	}{
+		{"yellow die on its number", nil, YellowPlacement(ColorYellow, 0, 0), Dice{ColorYellow: 3}, nil},
+		{"yellow die on another number", nil, YellowPlacement(ColorYellow, 0, 0), Dice{ColorYellow: 4}, ErrWrongBox},
+		{"yellow box already marked", []func(ss *ScoreSheet) ([]Reward, error){yellow(0, 0)},
+			YellowPlacement(ColorYellow, 0, 0), Dice{ColorYellow: 3}, ErrAlreadyMarked},
+		{"yellow pre-crossed box", nil, YellowPlacement(ColorYellow, 0, 3), Dice{ColorYellow: 0}, ErrInvalidDieValue},
+		{"white die as yellow", nil, YellowPlacement(ColorWhite, 1, 1), Dice{ColorWhite: 1}, nil},
+		{"white die as green", nil, Placement{Die: ColorWhite, Area: ColorGreen}, Dice{ColorWhite: 1}, nil},
+		{"coloured die in another area", nil, Placement{Die: ColorYellow, Area: ColorOrange}, Dice{ColorYellow: 3}, ErrWrongDie},
+		{"green above the threshold", []func(ss *ScoreSheet) ([]Reward, error){greens(6, 6, 6, 6)},
+			Placement{Die: ColorGreen, Area: ColorGreen, Box: 4}, Dice{ColorGreen: 5}, nil},
+		{"green below the threshold", []func(ss *ScoreSheet) ([]Reward, error){greens(6, 6, 6, 6)},
+			Placement{Die: ColorGreen, Area: ColorGreen, Box: 4}, Dice{ColorGreen: 4}, ErrValueTooLow},
+		{"green skipping a box", nil, Placement{Die: ColorGreen, Area: ColorGreen, Box: 1}, Dice{ColorGreen: 6}, ErrWrongBox},
+		{"purple higher than the last", []func(ss *ScoreSheet) ([]Reward, error){purples(4)},
+			Placement{Die: ColorPurple, Area: ColorPurple, Box: 1}, Dice{ColorPurple: 5}, nil},
+		{"purple equal to the last", []func(ss *ScoreSheet) ([]Reward, error){purples(4)},
+			Placement{Die: ColorPurple, Area: ColorPurple, Box: 1}, Dice{ColorPurple: 4}, ErrValueTooLow},
+		{"purple lower than the last", []func(ss *ScoreSheet) ([]Reward, error){purples(2, 5)},
+			Placement{Die: ColorPurple, Area: ColorPurple, Box: 2}, Dice{ColorPurple: 3}, ErrValueTooLow},
+		{"purple after a 6", []func(ss *ScoreSheet) ([]Reward, error){purples(3, 6)},
+			Placement{Die: ColorPurple, Area: ColorPurple, Box: 2}, Dice{ColorPurple: 1}, nil},
+		{"blue on the dice sum", nil, Placement{Die: ColorBlue, Area: ColorBlue, Box: 5}, Dice{ColorBlue: 2, ColorWhite: 3}, nil},
+		{"white die on the blue sum", nil, Placement{Die: ColorWhite, Area: ColorBlue, Box: 5}, Dice{ColorBlue: 2, ColorWhite: 3}, nil},
+		{"blue on another sum", nil, Placement{Die: ColorBlue, Area: ColorBlue, Box: 6}, Dice{ColorBlue: 2, ColorWhite: 3}, ErrWrongBox},
+		{"blue sum already crossed", []func(ss *ScoreSheet) ([]Reward, error){blue(5)},
+			Placement{Die: ColorBlue, Area: ColorBlue, Box: 5}, Dice{ColorBlue: 2, ColorWhite: 3}, ErrAlreadyMarked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := sheetWith(t, tt.marks...)
+			err := ss.CanPlace(tt.p, tt.dice)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CanPlace(%s) = %v, want nil", tt.p, err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("CanPlace(%s) = %v, want %v", tt.p, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLegalMoves(t *testing.T) {
+	roll := Dice{ColorWhite: 6, ColorYellow: 1, ColorBlue: 2, ColorGreen: 4, ColorOrange: 3, ColorPurple: 2}
+
+	tests := []struct {
+		name      string
+		marks     []func(ss *ScoreSheet) ([]Reward, error)
+		available []Color
+		want      map[Color]int // number of moves per area
+	}{
+		{"yellow die matches its number", nil, []Color{ColorYellow}, map[Color]int{ColorYellow: 2}},
+		{"white die goes anywhere", nil, []Color{ColorWhite},
+			map[Color]int{ColorYellow: 2, ColorGreen: 1, ColorOrange: 1, ColorPurple: 1, ColorBlue: 1}},
+		{"green below the threshold", []func(ss *ScoreSheet) ([]Reward, error){greens(6, 6, 6, 6)},
+			[]Color{ColorGreen}, map[Color]int{}},
+		{"purple not higher", []func(ss *ScoreSheet) ([]Reward, error){purples(5)},
+			[]Color{ColorPurple}, map[Color]int{}},
+		{"purple after a 6", []func(ss *ScoreSheet) ([]Reward, error){purples(5, 6)},
+			[]Color{ColorPurple}, map[Color]int{ColorPurple: 1}},
+		{"blue sum crossed", []func(ss *ScoreSheet) ([]Reward, error){blue(8)},
+			[]Color{ColorBlue, ColorWhite}, map[Color]int{ColorYellow: 2, ColorGreen: 1, ColorOrange: 1, ColorPurple: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := sheetWith(t, tt.marks...)
+			got := make(map[Color]int)
+			for _, move := range ss.LegalMoves(roll, tt.available) {
+				got[move.Area]++
+				if move.Value != move.Placement.Value(roll) {
+					t.Errorf("%s enters %d, want %d", move, move.Value, move.Placement.Value(roll))
+				}
+			}
+			for _, area := range []Color{ColorYellow, ColorGreen, ColorOrange, ColorPurple, ColorBlue} {
+				if got[area] != tt.want[area] {
+					t.Errorf("%d %s moves, want %d", got[area], area, tt.want[area])
+				}
+			}
+		})
+	}
+}
+
+func TestLegalMovesCarryRewards(t *testing.T) {
+	tests := []struct {
+		name       string
+		marks      []func(ss *ScoreSheet) ([]Reward, error)
+		dice       Dice
+		available  []Color
+		area       Color
+		wantPoints int
+		want       []Reward
+	}{
+		{"third orange earns a reroll", []func(ss *ScoreSheet) ([]Reward, error){oranges(1, 1)},
+			Dice{ColorOrange: 5}, []Color{ColorOrange}, ColorOrange, 5,
+			[]Reward{{Kind: RewardReroll, Source: "orange box 3"}}},
+		{"yellow row earns a free blue cross", []func(ss *ScoreSheet) ([]Reward, error){yellow(0, 0), yellow(0, 1)},
+			Dice{ColorYellow: 5}, []Color{ColorYellow}, ColorYellow, 0,
+			[]Reward{{Kind: RewardFreeMark, Color: ColorBlue, Source: "yellow row 1"}}},
+		{"fourth green earns a +1 action", []func(ss *ScoreSheet) ([]Reward, error){greens(6, 6, 6)},
+			Dice{ColorGreen: 4}, []Color{ColorGreen}, ColorGreen, GreenPoints(4) - GreenPoints(3),
+			[]Reward{{Kind: RewardPlusOne, Source: "green box 4"}}},
+		{"a plain mark earns nothing", nil,
+			Dice{ColorPurple: 3}, []Color{ColorPurple}, ColorPurple, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := sheetWith(t, tt.marks...)
+			before := ss.GetTotalScore()
+			var moves []Move
+			for _, move := range ss.LegalMoves(tt.dice, tt.available) {
+				if move.Area == tt.area && len(move.Rewards) == len(tt.want) {
+					moves = append(moves, move)
+				}
+			}
+			if len(moves) == 0 {
+				t.Fatalf("no %s move with %d rewards in %v", tt.area, len(tt.want), ss.LegalMoves(tt.dice, tt.available))
+			}
+			move := moves[0]
+			for i, reward := range move.Rewards {
+				if reward != tt.want[i] {
+					t.Errorf("reward %d = %+v, want %+v", i, reward, tt.want[i])
+				}
+			}
+			if move.Points != tt.wantPoints {
+				t.Errorf("move earns %d points, want %d", move.Points, tt.wantPoints)
+			}
+
+			// Listing the moves leaves the sheet as it was, and making one earns what it said
+			if ss.GetTotalScore() != before {
+				t.Errorf("listing moves changed the total from %d to %d", before, ss.GetTotalScore())
+			}
+			rewards, err := ss.ApplyMove(move)
+			if err != nil {
+				t.Fatalf("ApplyMove: %v", err)
+			}
+			if len(rewards) != len(move.Rewards) || ss.GetTotalScore() != before+move.Points {
+				t.Errorf("ApplyMove earned %v and %d points, want %v and %d", rewards, ss.GetTotalScore()-before, move.Rewards, move.Points)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package game
+
+// OrangeSize is the number of spaces on the orange row
+const OrangeSize = 11
+
+// orangeMultipliers is the multiplier printed on each orange space, left to right
+var orangeMultipliers = [OrangeSize]int{1, 1, 1, 2, 1, 1, 2, 1, 2, 1, 3}
+
+type OrangeScoreArea struct {
+	Total  int
+	Values []int // die values entered left to right, at most OrangeSize
+}
+
+// NewOrangeScoreArea creates an empty orange row
+func NewOrangeScoreArea() *OrangeScoreArea {
+	return &OrangeScoreArea{
+		Values: make([]int, 0, OrangeSize),
+	}
+}
+
+func (osa *OrangeScoreArea) Record(score int) {
+	osa.Total = score
+}
+
+// OrangeMultiplier returns the multiplier printed on an orange space
+func OrangeMultiplier(space int) int {
+	if space < 0 || space >= OrangeSize {
+		return 0
+	}
+	return orangeMultipliers[space]
+}
+
+// NextSpace returns the index of the next orange space to fill, or OrangeSize when full
+func (osa *OrangeScoreArea) NextSpace() int {
+	return len(osa.Values)
+}
+
+// CanMark reports whether a die value may be entered in the next orange space
+func (osa *OrangeScoreArea) CanMark(value int) error {
+	if value < 1 || value > 6 {
+		return ErrInvalidDieValue
+	}
+	if osa.NextSpace() >= OrangeSize {
+		return ErrAreaFull
+	}
+	return nil
+}
+
+// Mark enters a die value in the next orange space and updates the total
+func (osa *OrangeScoreArea) Mark(value int) error {
+	if err := osa.CanMark(value); err != nil {
+		return err
+	}
+	osa.Values = append(osa.Values, value)
+	osa.Total = calculateOrangeScore(osa)
+	return nil
+}
+
+// Unmark removes the last orange entry and updates the total
+func (osa *OrangeScoreArea) Unmark() error {
+	if len(osa.Values) == 0 {
+		return ErrAreaEmpty
+	}
+	osa.Values = osa.Values[:len(osa.Values)-1]
+	osa.Total = calculateOrangeScore(osa)
+	return nil
+}
+
+func calculateOrangeScore(orange *OrangeScoreArea) int {
+	score := 0
+	for space, value := range orange.Values {
+		score += value * orangeMultipliers[space]
+	}
+	return score
+}
@@ -6,16 +6,27 @@ type Player struct {
 	Name       string
 	ScoreSheet *ScoreSheet
 	IsActive   bool
+	Bot        Strategy // nil for a human player
 }
 
 func NewPlayer(name string) *Player {
+	return NewPlayerWithRuleset(name, DefaultRuleset())
+}
+
+// NewPlayerWithRuleset creates a player with an empty sheet for an edition of the game
+func NewPlayerWithRuleset(name string, ruleset Ruleset) *Player {
 	return &Player{
 		Name:       name,
-		ScoreSheet: NewScoreSheet(),
+		ScoreSheet: NewScoreSheetFor(ruleset),
 		IsActive:   false,
 	}
 }
 
+// IsBot reports whether the player is played by the computer
+func (p *Player) IsBot() bool {
+	return p.Bot != nil
+}
+
 func (p *Player) GetTotalScore() int {
 	return p.ScoreSheet.GetTotalScore()
 }
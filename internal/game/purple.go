@@ -0,0 +1,88 @@
+package game
+
+import "fmt"
+
+// PurpleSize is the number of spaces on the purple row
+const PurpleSize = 11
+
+// PurpleOrderError is returned when a purple value is not higher than the one before it
+type PurpleOrderError struct {
+	Value    int
+	Previous int
+}
+
+func (e *PurpleOrderError) Error() string {
+	return fmt.Sprintf("purple needs a value higher than %d (got %d), unless the previous value was a 6", e.Previous, e.Value)
+}
+
+// Unwrap lets callers match the error with errors.Is(err, ErrValueTooLow)
+func (e *PurpleOrderError) Unwrap() error {
+	return ErrValueTooLow
+}
+
+type PurpleScoreArea struct {
+	Total  int
+	Values []int // die values entered left to right, at most PurpleSize
+}
+
+// NewPurpleScoreArea creates an empty purple row
+func NewPurpleScoreArea() *PurpleScoreArea {
+	return &PurpleScoreArea{
+		Values: make([]int, 0, PurpleSize),
+	}
+}
+
+func (psa *PurpleScoreArea) Record(score int) {
+	psa.Total = score
+}
+
+// NextSpace returns the index of the next purple space to fill, or PurpleSize when full
+func (psa *PurpleScoreArea) NextSpace() int {
+	return len(psa.Values)
+}
+
+// CanMark reports whether a die value may be entered in the next purple space.
+// Each value must be higher than the previous one, except after a 6 where any value is allowed.
+func (psa *PurpleScoreArea) CanMark(value int) error {
+	if value < 1 || value > 6 {
+		return ErrInvalidDieValue
+	}
+	if psa.NextSpace() >= PurpleSize {
+		return ErrAreaFull
+	}
+	if len(psa.Values) > 0 {
+		previous := psa.Values[len(psa.Values)-1]
+		if previous != 6 && value <= previous {
+			return &PurpleOrderError{Value: value, Previous: previous}
+		}
+	}
+	return nil
+}
+
+// Mark enters a die value in the next purple space and updates the total
+func (psa *PurpleScoreArea) Mark(value int) error {
+	if err := psa.CanMark(value); err != nil {
+		return err
+	}
+	psa.Values = append(psa.Values, value)
+	psa.Total = calculatePurpleScore(psa)
+	return nil
+}
+
+// Unmark removes the last purple entry and updates the total
+func (psa *PurpleScoreArea) Unmark() error {
+	if len(psa.Values) == 0 {
+		return ErrAreaEmpty
+	}
+	psa.Values = psa.Values[:len(psa.Values)-1]
+	psa.Total = calculatePurpleScore(psa)
+	return nil
+}
+
+func calculatePurpleScore(purple *PurpleScoreArea) int {
+	score := 0
+	for _, value := range purple.Values {
+		score += value
+	}
+	return score
+}
@@ -0,0 +1,125 @@
+package game
+
+import "errors"
+
+// ErrNoPlayers is returned when starting a game without players
+var ErrNoPlayers = errors.New("game needs at least one player")
+
+// ErrGameOver is returned when advancing a game that has already ended
+var ErrGameOver = errors.New("game is over")
+
+// RoundsForPlayers returns the number of rounds played for a player count:
+// 6 rounds for 1-2 players, 5 for 3 players and 4 for 4 players
+func RoundsForPlayers(playerCount int) int {
+	switch {
+	case playerCount <= 2:
+		return 6
+	case playerCount == 3:
+		return 5
+	default:
+		return 4
+	}
+}
+
+// RoundTracker is the round and turn state machine for a game.
+// Each player is the active player once per round, in seat order.
+type RoundTracker struct {
+	Players     []*Player
+	Round       int // 1-based current round
+	TotalRounds int
+	ActiveIndex int
+	Finished    bool
+
+	// OnRoundStart is called when a round begins with the rewards every player must still apply
+	OnRoundStart func(round int, pending []Reward)
+	// OnGameEnd is called once after the last turn of the last round
+	OnGameEnd func()
+}
+
+// NewRoundTracker creates a tracker for the players; call Start to begin round 1
+func NewRoundTracker(players []*Player) (*RoundTracker, error) {
+	if len(players) == 0 {
+		return nil, ErrNoPlayers
+	}
+	return &RoundTracker{
+		Players:     players,
+		TotalRounds: players[0].ScoreSheet.Ruleset.RoundCount(len(players)),
+	}, nil
+}
+
+// Start begins round 1 with the first player active
+func (rt *RoundTracker) Start() {
+	rt.Round = 0
+	rt.Finished = false
+	rt.beginRound(1)
+}
+
+// ActivePlayer returns the player whose turn it is, or nil once the game is over
+func (rt *RoundTracker) ActivePlayer() *Player {
+	if rt.Finished || rt.ActiveIndex >= len(rt.Players) {
+		return nil
+	}
+	return rt.Players[rt.ActiveIndex]
+}
+
+// NextTurn passes the active role to the next player, starting a new round after the
+// last player and ending the game after the last round. It reports whether the game is over.
+func (rt *RoundTracker) NextTurn() (bool, error) {
+	if rt.Finished {
+		return true, ErrGameOver
+	}
+
+	if rt.ActiveIndex+1 < len(rt.Players) {
+		rt.setActive(rt.ActiveIndex + 1)
+		return false, nil
+	}
+
+	if rt.Round >= rt.TotalRounds {
+		rt.finish()
+		return true, nil
+	}
+
+	rt.beginRound(rt.Round + 1)
+	return false, nil
+}
+
+// beginRound grants the round-start rewards to every player and activates the first player
+func (rt *RoundTracker) beginRound(round int) {
+	rt.Round = round
+	for _, player := range rt.Players {
+		player.ScoreSheet.StartRound(round)
+	}
+	rt.setActive(0)
+
+	if rt.OnRoundStart != nil {
+		rt.OnRoundStart(round, pendingRoundRewards(round))
+	}
+}
+
+// pendingRoundRewards returns the round-start rewards that players must apply themselves
+func pendingRoundRewards(round int) []Reward {
+	var pending []Reward
+	for _, reward := range RoundRewards(round) {
+		if !reward.Automatic() {
+			pending = append(pending, reward)
+		}
+	}
+	return pending
+}
+
+func (rt *RoundTracker) setActive(index int) {
+	rt.ActiveIndex = index
+	for i, player := range rt.Players {
+		player.IsActive = i == index
+	}
+}
+
+func (rt *RoundTracker) finish() {
+	rt.Finished = true
+	for _, player := range rt.Players {
+		player.IsActive = false
+	}
+	if rt.OnGameEnd != nil {
+		rt.OnGameEnd()
+	}
+}
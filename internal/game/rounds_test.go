@@ -0,0 +1,145 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoundsForPlayers(t *testing.T) {
+	for players, want := range map[int]int{1: 6, 2: 6, 3: 5, 4: 4} {
+		if got := RoundsForPlayers(players); got != want {
+			t.Errorf("RoundsForPlayers(%d) = %d, want %d", players, got, want)
+		}
+
+		var seats []*Player
+		for range players {
+			seats = append(seats, NewPlayer("Player"))
+		}
+		rounds, err := NewRoundTracker(seats)
+		if err != nil {
+			t.Fatalf("NewRoundTracker: %v", err)
+		}
+		if rounds.TotalRounds != want {
+			t.Errorf("tracker for %d players plays %d rounds, want %d", players, rounds.TotalRounds, want)
+		}
+	}
+
+	if _, err := NewRoundTracker(nil); !errors.Is(err, ErrNoPlayers) {
+		t.Errorf("NewRoundTracker without players: error = %v, want ErrNoPlayers", err)
+	}
+}
+
+func TestNextTurnRotatesTheActivePlayer(t *testing.T) {
+	players := []*Player{NewPlayer("Alice"), NewPlayer("Bob"), NewPlayer("Carol")}
+	rounds, err := NewRoundTracker(players)
+	if err != nil {
+		t.Fatalf("NewRoundTracker: %v", err)
+	}
+	rounds.Start()
+
+	for round := 1; round <= 2; round++ {
+		for i, want := range players {
+			if rounds.Round != round || rounds.ActivePlayer() != want {
+				t.Fatalf("turn %d of round %d: round %d with %v active, want %s",
+					i+1, round, rounds.Round, rounds.ActivePlayer(), want.Name)
+			}
+			for _, player := range players {
+				if player.IsActive != (player == want) {
+					t.Errorf("round %d: %s active = %v while %s is active", round, player.Name, player.IsActive, want.Name)
+				}
+			}
+			if over, err := rounds.NextTurn(); over || err != nil {
+				t.Fatalf("NextTurn = %v, %v", over, err)
+			}
+		}
+	}
+}
+
+func TestRoundStartRewardsAreGrantedOnce(t *testing.T) {
+	players := []*Player{NewPlayer("Alice"), NewPlayer("Bob")}
+	rounds, err := NewRoundTracker(players)
+	if err != nil {
+		t.Fatalf("NewRoundTracker: %v", err)
+	}
+	pending := make(map[int][]Reward)
+	rounds.OnRoundStart = func(round int, rewards []Reward) {
+		if _, ok := pending[round]; ok {
+			t.Errorf("round %d started twice", round)
+		}
+		pending[round] = rewards
+	}
+	rounds.Start()
+
+	// Play into round 4; each round has one turn per player
+	for rounds.Round < 4 {
+		if _, err := rounds.NextTurn(); err != nil {
+			t.Fatalf("NextTurn: %v", err)
+		}
+	}
+	for _, player := range players {
+		ss := player.ScoreSheet
+		if ss.Rerolls.FromRounds != 2 || ss.PlusOnes.FromRounds != 1 || ss.Round != 4 {
+			t.Errorf("%s has %d rerolls and %d +1 actions from rounds 1-%d, want 2 and 1 by round 4",
+				player.Name, ss.Rerolls.FromRounds, ss.PlusOnes.FromRounds, ss.Round)
+		}
+		// Starting a round again grants nothing new
+		if again := ss.StartRound(3); len(again) != 0 || ss.Rerolls.FromRounds != 2 {
+			t.Errorf("%s got %v and %d rerolls from starting round 3 again", player.Name, again, ss.Rerolls.FromRounds)
+		}
+	}
+
+	for round := 1; round <= 3; round++ {
+		if len(pending[round]) != 0 {
+			t.Errorf("round %d left %v to apply, want its actions granted automatically", round, pending[round])
+		}
+	}
+	want := Reward{Kind: RewardFreeMark, Value: 6, Source: "round 4"}
+	if len(pending[4]) != 1 || pending[4][0] != want {
+		t.Errorf("round 4 left %v to apply, want the free 6", pending[4])
+	}
+}
+
+func TestGameEndsOnceAfterTheLastRound(t *testing.T) {
+	players := []*Player{NewPlayer("Alice"), NewPlayer("Bob"), NewPlayer("Carol"), NewPlayer("Dave")}
+	rounds, err := NewRoundTracker(players)
+	if err != nil {
+		t.Fatalf("NewRoundTracker: %v", err)
+	}
+	ended := 0
+	rounds.OnGameEnd = func() { ended++ }
+	rounds.Start()
+
+	turns := 0
+	for {
+		over, err := rounds.NextTurn()
+		if err != nil {
+			t.Fatalf("NextTurn: %v", err)
+		}
+		turns++
+		if over {
+			break
+		}
+	}
+	if want := len(players) * rounds.TotalRounds; turns != want {
+		t.Errorf("game lasted %d turns, want %d", turns, want)
+	}
+	if !rounds.Finished || rounds.ActivePlayer() != nil || ended != 1 {
+		t.Errorf("finished %v, active %v, ended %d times, want finished with nobody active and one end", rounds.Finished, rounds.ActivePlayer(), ended)
+	}
+	for _, player := range players {
+		if player.IsActive {
+			t.Errorf("%s is still active after the game", player.Name)
+		}
+	}
+
+	over, err := rounds.NextTurn()
+	if !over || !errors.Is(err, ErrGameOver) {
+		t.Errorf("NextTurn after the game = %v, %v, want true and ErrGameOver", over, err)
+	}
+	if _, err := rounds.PlayTurn(rollDice(1, 2, 3, 4, 5, 6)); !errors.Is(err, ErrGameOver) {
+		t.Errorf("PlayTurn after the game: error = %v, want ErrGameOver", err)
+	}
+	if ended != 1 {
+		t.Errorf("game ended %d times, want once", ended)
+	}
+}
@@ -0,0 +1,182 @@
+package game
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// ColorSilver is the grey/silver area of Doppelt so clever
+	ColorSilver Color = "silver"
+	// ColorPink is the pink area of Doppelt so clever
+	ColorPink Color = "pink"
+)
+
+const (
+	RulesetGanzSchoenClever = "ganz-schoen-clever"
+	RulesetDoppeltSoClever  = "doppelt-so-clever"
+)
+
+// Ruleset describes one edition of the game: which areas a sheet has,
+// how each area is scored and how the bonus is derived
+type Ruleset interface {
+	ID() string
+	Name() string
+	// Areas lists the scoring areas in the order they are printed on the sheet
+	Areas() []Color
+	// Area returns the score area of a sheet for a colour, or nil if the edition has no such area
+	Area(ss *ScoreSheet, area Color) ScoreArea
+	// AreaTotal returns the score of one area
+	AreaTotal(ss *ScoreSheet, area Color) int
+	// Bonus calculates the bonus from the area scores and foxes
+	Bonus(ss *ScoreSheet) int
+	// RoundCount returns the number of rounds played for a player count
+	RoundCount(playerCount int) int
+	// MarkLevel reports whether areas can be scored box by box rather than by typed totals
+	MarkLevel() bool
+}
+
+// AreaName returns the display name of an area
+func AreaName(area Color) string {
+	name := string(area)
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// TotalArea is an area scored only by a typed total
+type TotalArea struct {
+	Total int
+}
+
+func (ta *TotalArea) Record(score int) {
+	ta.Total = score
+}
+
+var rulesets = []Ruleset{
+	ganzSchoenClever{},
+	doppeltSoClever{},
+}
+
+// Rulesets returns every supported edition, the base game first
+func Rulesets() []Ruleset {
+	return rulesets
+}
+
+// DefaultRuleset returns the base game
+func DefaultRuleset() Ruleset {
+	return rulesets[0]
+}
+
+// RulesetByID returns the edition with the given ID, falling back to the base game
+// so games saved before rulesets existed keep loading
+func RulesetByID(id string) Ruleset {
+	for _, ruleset := range rulesets {
+		if ruleset.ID() == id {
+			return ruleset
+		}
+	}
+	return DefaultRuleset()
+}
+
+// ganzSchoenClever is the base game with mark-level scoring for all five areas
+type ganzSchoenClever struct{}
+
+func (ganzSchoenClever) ID() string   { return RulesetGanzSchoenClever }
+func (ganzSchoenClever) Name() string { return "Ganz schön clever" }
+
+func (ganzSchoenClever) Areas() []Color {
+	return []Color{ColorYellow, ColorGreen, ColorOrange, ColorPurple, ColorBlue}
+}
+
+func (ganzSchoenClever) Area(ss *ScoreSheet, area Color) ScoreArea {
+	switch area {
+	case ColorYellow:
+		return ss.Yellow
+	case ColorGreen:
+		return ss.Green
+	case ColorOrange:
+		return ss.Orange
+	case ColorPurple:
+		return ss.Purple
+	case ColorBlue:
+		return ss.Blue
+	default:
+		return nil
+	}
+}
+
+func (ganzSchoenClever) AreaTotal(ss *ScoreSheet, area Color) int {
+	switch area {
+	case ColorYellow:
+		return ss.Yellow.Total
+	case ColorGreen:
+		return ss.Green.Total
+	case ColorOrange:
+		return ss.Orange.Total
+	case ColorPurple:
+		return ss.Purple.Total
+	case ColorBlue:
+		return ss.Blue.Total
+	default:
+		return 0
+	}
+}
+
+func (r ganzSchoenClever) Bonus(ss *ScoreSheet) int {
+	return foxBonusForAreas(r, ss)
+}
+
+func (ganzSchoenClever) RoundCount(playerCount int) int {
+	return RoundsForPlayers(playerCount)
+}
+
+func (ganzSchoenClever) MarkLevel() bool { return true }
+
+// doppeltSoClever is the "Twice as Clever" sequel. Only typed area totals are supported:
+// its areas have no box-by-box scoring or printed rewards here, so sheets cannot be ticked,
+// foxes are typed by hand, and bots and the move advisor are not available. The fox bonus
+// uses the same rule as the base game.
+type doppeltSoClever struct{}
+
+func (doppeltSoClever) ID() string   { return RulesetDoppeltSoClever }
+func (doppeltSoClever) Name() string { return "Doppelt so clever" }
+
+func (doppeltSoClever) Areas() []Color {
+	return []Color{ColorSilver, ColorYellow, ColorBlue, ColorGreen, ColorPink}
+}
+
+func (r doppeltSoClever) Area(ss *ScoreSheet, area Color) ScoreArea {
+	if !slices.Contains(r.Areas(), area) {
+		return nil
+	}
+	return ss.totalArea(area)
+}
+
+func (r doppeltSoClever) AreaTotal(ss *ScoreSheet, area Color) int {
+	if !slices.Contains(r.Areas(), area) {
+		return 0
+	}
+	return ss.totalArea(area).Total
+}
+
+func (r doppeltSoClever) Bonus(ss *ScoreSheet) int {
+	return foxBonusForAreas(r, ss)
+}
+
+func (doppeltSoClever) RoundCount(playerCount int) int {
+	return RoundsForPlayers(playerCount)
+}
+
+func (doppeltSoClever) MarkLevel() bool { return false }
+
+// foxBonusForAreas applies the fox bonus rule shared by both editions
+func foxBonusForAreas(r Ruleset, ss *ScoreSheet) int {
+	areas := r.Areas()
+	sections := make([]int, 0, len(areas))
+	for _, area := range areas {
+		sections = append(sections, r.AreaTotal(ss, area))
+	}
+	return FoxBonus(ss.Bonus.FoxCount, sections)
+}
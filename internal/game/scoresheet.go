@@ -1,222 +1,192 @@
 package game
 
+import "errors"
+
+var (
+	// ErrBoxOutOfRange is returned when a mark targets a box that is not printed on the sheet
+	ErrBoxOutOfRange = errors.New("box is not on the score sheet")
+	// ErrAlreadyMarked is returned when a box is crossed off twice
+	ErrAlreadyMarked = errors.New("box is already marked")
+	// ErrNotMarked is returned when removing a mark from an empty box
+	ErrNotMarked = errors.New("box is not marked")
+	// ErrAreaFull is returned when every box in a row area is already filled
+	ErrAreaFull = errors.New("area is already full")
+	// ErrAreaEmpty is returned when removing the last entry from an empty row area
+	ErrAreaEmpty = errors.New("area has no entries")
+	// ErrInvalidDieValue is returned for values that cannot be rolled on a die
+	ErrInvalidDieValue = errors.New("die value must be between 1 and 6")
+	// ErrValueTooLow is returned when a die value does not meet the box requirement
+	ErrValueTooLow = errors.New("die value is too low for this box")
+)
+
 type ScoreArea interface {
 	Record(int)
 }
 
 type ScoreSheet struct {
+	Ruleset Ruleset
+
+	// Mark-level areas of the base game
 	Yellow *YellowScoreArea
 	Green  *GreenScoreArea
 	Orange *OrangeScoreArea
 	Purple *PurpleScoreArea
 	Blue   *BlueScoreArea
 	Bonus  *BonusArea
-}
-
-type YellowScoreArea struct {
-	Total   int
-	Columns [6][]bool // 6 columns, each with numbers 1-6
-}
-
-func (ysa *YellowScoreArea) Record(score int) {
-	ysa.Total = score
-}
-
-type GreenScoreArea struct {
-	Total   int
-	Numbers []bool // 11 numbers: 2,3,4,5,6,7,8,9,10,11,12
-}
-
-func (gsa *GreenScoreArea) Record(score int) {
-	gsa.Total = score
-}
-
-type OrangeScoreArea struct {
-	Total   int
-	Numbers []int // 11 spaces for any numbers
-}
-
-func (osa *OrangeScoreArea) Record(score int) {
-	osa.Total = score
-}
-
-type PurpleScoreArea struct {
-	Total   int
-	Numbers []bool // 11 numbers: 1-11, with special 6 reset
-}
-
-func (psa *PurpleScoreArea) Record(score int) {
-	psa.Total = score
-}
 
-type BlueScoreArea struct {
-	Total   int
-	Numbers []bool // 11 numbers: 1-11
-}
+	Rerolls  *ActionTrack
+	PlusOnes *ActionTrack
+	Round    int // last round whose start rewards were granted
 
-func (bsa *BlueScoreArea) Record(score int) {
-	bsa.Total = score
+	// Totals holds the areas of editions that are scored by typed totals only
+	Totals map[Color]*TotalArea
 }
 
 type BonusArea struct {
 	Total    int // Calculated as lowest_section_score * FoxCount
-	FoxCount int
+	FoxCount int // Typed by hand, or derived from the marks once boxes are ticked
 }
 
 func (bas *BonusArea) Record(score int) {
 	bas.FoxCount = score
 }
 
+// NewScoreSheet creates an empty sheet for the base game
 func NewScoreSheet() *ScoreSheet {
+	return NewScoreSheetFor(DefaultRuleset())
+}
+
+// NewScoreSheetFor creates an empty sheet for an edition of the game
+func NewScoreSheetFor(ruleset Ruleset) *ScoreSheet {
 	return &ScoreSheet{
-		Yellow: &YellowScoreArea{
-			Columns: [6][]bool{
-				make([]bool, 6), // Column 1: numbers 1-6
-				make([]bool, 6), // Column 2: numbers 1-6
-				make([]bool, 6), // Column 3: numbers 1-6
-				make([]bool, 6), // Column 4: numbers 1-6
-				make([]bool, 6), // Column 5: numbers 1-6
-				make([]bool, 6), // Column 6: numbers 1-6
-			},
-		},
-		Green: &GreenScoreArea{
-			Numbers: make([]bool, 11),
-		},
-		Orange: &OrangeScoreArea{
-			Numbers: make([]int, 11),
-		},
-		Purple: &PurpleScoreArea{
-			Numbers: make([]bool, 11),
-		},
-		Blue: &BlueScoreArea{
-			Numbers: make([]bool, 11),
-		},
+		Ruleset: ruleset,
+		Yellow:  NewYellowScoreArea(),
+		Green:   NewGreenScoreArea(),
+		Orange:  NewOrangeScoreArea(),
+		Purple:  NewPurpleScoreArea(),
+		Blue:    NewBlueScoreArea(),
 		Bonus: &BonusArea{
 			FoxCount: 0,
 		},
+		Rerolls:  &ActionTrack{},
+		PlusOnes: &ActionTrack{},
+		Totals:   make(map[Color]*TotalArea),
 	}
 }
 
-func (ss *ScoreSheet) GetTotalScore() int {
-	return ss.Yellow.Total + ss.Green.Total + ss.Orange.Total + ss.Purple.Total + ss.Blue.Total + ss.Bonus.Total
+// Area returns the score area for a colour in the sheet's edition, or nil if it has none
+func (ss *ScoreSheet) Area(area Color) ScoreArea {
+	return ss.Ruleset.Area(ss, area)
 }
 
-func (ss *ScoreSheet) CalculateBonus() {
-	// Calculate bonus as lowest section score * fox count
-	sections := []int{ss.Yellow.Total, ss.Green.Total, ss.Orange.Total, ss.Purple.Total, ss.Blue.Total}
+// AreaTotal returns the score of one area in the sheet's edition
+func (ss *ScoreSheet) AreaTotal(area Color) int {
+	return ss.Ruleset.AreaTotal(ss, area)
+}
 
-	// Find lowest non-zero section
-	lowest := 0
-	for _, section := range sections {
-		if section > 0 && (lowest == 0 || section < lowest) {
-			lowest = section
-		}
+// totalArea returns the typed-total area for a colour, creating it on first use
+func (ss *ScoreSheet) totalArea(area Color) *TotalArea {
+	ta, ok := ss.Totals[area]
+	if !ok {
+		ta = &TotalArea{}
+		ss.Totals[area] = ta
 	}
+	return ta
+}
 
-	ss.Bonus.Total = lowest * ss.Bonus.FoxCount
+func (ss *ScoreSheet) GetTotalScore() int {
+	total := ss.Bonus.Total
+	for _, area := range ss.Ruleset.Areas() {
+		total += ss.AreaTotal(area)
+	}
+	return total
 }
 
-func calculateYellowScore(yellow *YellowScoreArea) int {
-	score := 0
-	for col := range 6 {
-		columnComplete := true
-		for row := range 6 {
-			if !yellow.Columns[col][row] {
-				columnComplete = false
-				break
+// HasMarks reports whether any box has been ticked. From then on the fox count is derived
+// from the marks and can no longer be typed by hand.
+func (ss *ScoreSheet) HasMarks() bool {
+	for row := range YellowSize {
+		for col := range YellowSize {
+			if ss.Yellow.IsMarked(row, col) && !YellowPreCrossed(row, col) {
+				return true
 			}
 		}
-		if columnComplete {
-			score += (col + 1) * (col + 1)
-		}
 	}
-	return score
+	return len(ss.Green.Values) > 0 || len(ss.Orange.Values) > 0 || len(ss.Purple.Values) > 0 || ss.Blue.Count() > 0
 }
 
-func calculateGreenScore(green *GreenScoreArea) int {
-	score := 0
-	consecutiveCount := 0
+func (ss *ScoreSheet) CalculateBonus() {
+	ss.Bonus.Total = ss.Ruleset.Bonus(ss)
+}
 
-	for i := range green.Numbers {
-		if green.Numbers[i] {
-			consecutiveCount++
-		} else {
-			if consecutiveCount > 0 {
-				score += consecutiveCount * consecutiveCount
-			}
-			consecutiveCount = 0
-		}
+// FoxBonus calculates the fox bonus as fox count * lowest section score.
+// An empty section counts as zero, so a sheet with an unscored area earns no bonus.
+func FoxBonus(foxCount int, sections []int) int {
+	if foxCount <= 0 || len(sections) == 0 {
+		return 0
 	}
 
-	if consecutiveCount > 0 {
-		score += consecutiveCount * consecutiveCount
+	lowest := sections[0]
+	for _, section := range sections[1:] {
+		if section < lowest {
+			lowest = section
+		}
+	}
+	if lowest < 0 {
+		lowest = 0
 	}
 
-	return score
+	return lowest * foxCount
 }
 
-func calculateOrangeScore(orange *OrangeScoreArea) int {
-	score := 0
-	for _, num := range orange.Numbers {
-		if num > 0 {
-			score += num
-		}
-	}
-	return score
-}
-
-func calculatePurpleScore(purple *PurpleScoreArea) int {
-	score := 0
-	for i, marked := range purple.Numbers {
-		if marked {
-			if i < 5 { // Numbers 1-5
-				score += i + 1
-			} else if i == 5 { // Number 6
-				score += 6
-			} else { // Numbers 7-11
-				score += i + 1
-			}
-		}
-	}
-	return score
+// MarkYellow crosses off a box in the yellow grid, recalculates the totals and returns the rewards unlocked
+func (ss *ScoreSheet) MarkYellow(row, col int) ([]Reward, error) {
+	return ss.recordMark(func() error { return ss.Yellow.Mark(row, col) })
 }
 
-func calculateBlueScore(blue *BlueScoreArea) int {
-	score := 0
-	markedCount := 0
+// UnmarkYellow removes a cross from the yellow grid and recalculates the totals
+func (ss *ScoreSheet) UnmarkYellow(row, col int) error {
+	return ss.removeMark(func() error { return ss.Yellow.Unmark(row, col) })
+}
 
-	for _, marked := range blue.Numbers {
-		if marked {
-			markedCount++
-		}
-	}
+// MarkGreen enters a die value in the next green box, recalculates the totals and returns the rewards unlocked
+func (ss *ScoreSheet) MarkGreen(value int) ([]Reward, error) {
+	return ss.recordMark(func() error { return ss.Green.Mark(value) })
+}
 
-	switch markedCount {
-	case 1:
-		score = 1
-	case 2:
-		score = 3
-	case 3:
-		score = 6
-	case 4:
-		score = 10
-	case 5:
-		score = 15
-	case 6:
-		score = 21
-	case 7:
-		score = 28
-	case 8:
-		score = 36
-	case 9:
-		score = 45
-	case 10:
-		score = 55
-	case 11:
-		score = 66
-	}
+// UnmarkGreen removes the last green entry and recalculates the totals
+func (ss *ScoreSheet) UnmarkGreen() error {
+	return ss.removeMark(ss.Green.Unmark)
+}
+
+// MarkOrange enters a die value in the next orange space, recalculates the totals and returns the rewards unlocked
+func (ss *ScoreSheet) MarkOrange(value int) ([]Reward, error) {
+	return ss.recordMark(func() error { return ss.Orange.Mark(value) })
+}
+
+// UnmarkOrange removes the last orange entry and recalculates the totals
+func (ss *ScoreSheet) UnmarkOrange() error {
+	return ss.removeMark(ss.Orange.Unmark)
+}
+
+// MarkPurple enters a die value in the next purple space, recalculates the totals and returns the rewards unlocked
+func (ss *ScoreSheet) MarkPurple(value int) ([]Reward, error) {
+	return ss.recordMark(func() error { return ss.Purple.Mark(value) })
+}
+
+// UnmarkPurple removes the last purple entry and recalculates the totals
+func (ss *ScoreSheet) UnmarkPurple() error {
+	return ss.removeMark(ss.Purple.Unmark)
+}
+
+// MarkBlue crosses off the blue box for a dice sum, recalculates the totals and returns the rewards unlocked
+func (ss *ScoreSheet) MarkBlue(sum int) ([]Reward, error) {
+	return ss.recordMark(func() error { return ss.Blue.Mark(sum) })
+}
 
-	return score
+// UnmarkBlue removes the cross from the blue box for a dice sum and recalculates the totals
+func (ss *ScoreSheet) UnmarkBlue(sum int) error {
+	return ss.removeMark(func() error { return ss.Blue.Unmark(sum) })
 }
 
 func calculateBonusScore(bonus *BonusArea) int {
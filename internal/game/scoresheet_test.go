@@ -0,0 +1,89 @@
+package game
+
+import "testing"
+
+func TestFoxBonus(t *testing.T) {
+	tests := []struct {
+		name     string
+		foxes    int
+		sections []int
+		want     int
+	}{
+		{"no foxes", 0, []int{30, 28, 40, 25, 22}, 0},
+		{"negative foxes", -1, []int{30, 28, 40, 25, 22}, 0},
+		{"one fox", 1, []int{30, 28, 40, 25, 22}, 22},
+		{"three foxes", 3, []int{30, 28, 40, 25, 22}, 66},
+		{"five foxes", 5, []int{60, 66, 92, 66, 56}, 280},
+		{"empty area counts as lowest", 3, []int{30, 28, 40, 25, 0}, 0},
+		{"all empty", 2, []int{0, 0, 0, 0, 0}, 0},
+		{"lowest first", 2, []int{4, 10, 12, 18, 20}, 8},
+		{"tied lowest", 2, []int{14, 14, 30, 30, 40}, 28},
+		{"no sections", 3, nil, 0},
+		{"negative section clamps to zero", 2, []int{-5, 10, 12, 18, 20}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FoxBonus(tt.foxes, tt.sections); got != tt.want {
+				t.Errorf("FoxBonus(%d, %v) = %d, want %d", tt.foxes, tt.sections, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateBonus(t *testing.T) {
+	tests := []struct {
+		name                                string
+		yellow, green, orange, purple, blue int
+		foxes                               int
+		wantBonus                           int
+		wantTotal                           int
+	}{
+		{"empty sheet", 0, 0, 0, 0, 0, 0, 0, 0},
+		{"empty blue with three foxes", 30, 28, 40, 25, 0, 3, 0, 123},
+		{"typical game", 30, 21, 37, 32, 22, 2, 42, 184},
+		{"strong game", 50, 36, 62, 45, 37, 4, 144, 374},
+		{"no foxes", 50, 36, 62, 45, 37, 0, 0, 230},
+		{"lowest is yellow", 10, 36, 62, 45, 37, 3, 30, 220},
+		{"lowest is green", 40, 15, 62, 45, 37, 1, 15, 214},
+		{"lowest is orange", 40, 45, 12, 45, 37, 2, 24, 203},
+		{"lowest is purple", 40, 45, 50, 9, 37, 5, 45, 226},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := NewScoreSheet()
+			ss.Yellow.Record(tt.yellow)
+			ss.Green.Record(tt.green)
+			ss.Orange.Record(tt.orange)
+			ss.Purple.Record(tt.purple)
+			ss.Blue.Record(tt.blue)
+			ss.Bonus.Record(tt.foxes)
+			ss.CalculateBonus()
+
+			if ss.Bonus.Total != tt.wantBonus {
+				t.Errorf("bonus = %d, want %d", ss.Bonus.Total, tt.wantBonus)
+			}
+			if got := ss.GetTotalScore(); got != tt.wantTotal {
+				t.Errorf("total = %d, want %d", got, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestCalculateBonusFromMarks(t *testing.T) {
+	ss := NewScoreSheet()
+
+	// Completing the last yellow row earns a fox while every other area is still empty
+	for _, col := range []int{1, 2, 3} {
+		if _, err := ss.MarkYellow(3, col); err != nil {
+			t.Fatalf("MarkYellow(3, %d): %v", col, err)
+		}
+	}
+	if ss.Bonus.FoxCount != 1 {
+		t.Fatalf("fox count = %d, want 1", ss.Bonus.FoxCount)
+	}
+	if ss.Bonus.Total != 0 {
+		t.Errorf("bonus with empty areas = %d, want 0", ss.Bonus.Total)
+	}
+}
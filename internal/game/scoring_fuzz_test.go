@@ -0,0 +1,150 @@
+package game
+
+import (
+	"testing"
+)
+
+// fuzzMark enters one mark chosen by two fuzz bytes and returns how to remove it from a
+// sheet, or nil when the mark is not legal on the sheet
+func fuzzMark(ss *ScoreSheet, op, arg byte) func(*ScoreSheet) error {
+	value := int(arg%6) + 1
+	switch op % 5 {
+	case 0:
+		row, col := int(arg/YellowSize)%YellowSize, int(arg)%YellowSize
+		if _, err := ss.MarkYellow(row, col); err != nil {
+			return nil
+		}
+		return func(ss *ScoreSheet) error { return ss.UnmarkYellow(row, col) }
+	case 1:
+		if _, err := ss.MarkGreen(value); err != nil {
+			return nil
+		}
+		return (*ScoreSheet).UnmarkGreen
+	case 2:
+		if _, err := ss.MarkOrange(value); err != nil {
+			return nil
+		}
+		return (*ScoreSheet).UnmarkOrange
+	case 3:
+		if _, err := ss.MarkPurple(value); err != nil {
+			return nil
+		}
+		return (*ScoreSheet).UnmarkPurple
+	default:
+		sum := BlueMinSum + int(arg)%(BlueMaxSum-BlueMinSum+1)
+		if _, err := ss.MarkBlue(sum); err != nil {
+			return nil
+		}
+		return func(ss *ScoreSheet) error { return ss.UnmarkBlue(sum) }
+	}
+}
+
+// checkSheetInvariants verifies the scoring rules that hold for every reachable sheet
+func checkSheetInvariants(t *testing.T, ss *ScoreSheet) {
+	t.Helper()
+	sections := make([]int, 0, len(ss.Ruleset.Areas()))
+	sum := 0
+	for _, area := range ss.Ruleset.Areas() {
+		total := ss.AreaTotal(area)
+		if total < 0 {
+			t.Fatalf("%s total %d is negative", area, total)
+		}
+		sections = append(sections, total)
+		sum += total
+	}
+	if ss.Bonus.FoxCount != ss.EarnedFoxes() {
+		t.Fatalf("fox count %d, sheet earns %d", ss.Bonus.FoxCount, ss.EarnedFoxes())
+	}
+	if want := FoxBonus(ss.Bonus.FoxCount, sections); ss.Bonus.Total != want {
+		t.Fatalf("bonus %d, want %d foxes * lowest of %v = %d", ss.Bonus.Total, ss.Bonus.FoxCount, sections, want)
+	}
+	if got := ss.GetTotalScore(); got != sum+ss.Bonus.Total {
+		t.Fatalf("total %d, want areas %d + bonus %d", got, sum, ss.Bonus.Total)
+	}
+}
+
+// FuzzScoreSheet plays arbitrary sequences of marks and checks that scores never go negative,
+// never drop when a box is filled, follow the fox bonus formula and are restored by undoing a mark
+func FuzzScoreSheet(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 1, 0, 2, 5, 3, 1, 4, 0})
+	f.Add([]byte{1, 0, 1, 1, 1, 2, 1, 3, 1, 4, 1, 5, 1, 1, 1, 2})
+	f.Add([]byte{3, 5, 3, 5, 3, 5, 3, 5, 2, 5, 2, 5, 2, 5, 2, 5, 2, 5})
+	f.Add([]byte{4, 0, 4, 1, 4, 2, 4, 3, 4, 4, 4, 5, 4, 6, 4, 7, 4, 8, 4, 9, 4, 10})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		ss := NewScoreSheet()
+		checkSheetInvariants(t, ss)
+
+		for i := 0; i+1 < len(data); i += 2 {
+			before := make(map[Color]int)
+			for _, area := range ss.Ruleset.Areas() {
+				before[area] = ss.AreaTotal(area)
+			}
+			beforeTotal := ss.GetTotalScore()
+			beforeFoxes := ss.Bonus.FoxCount
+
+			undo := fuzzMark(ss, data[i], data[i+1])
+			if undo == nil {
+				continue
+			}
+			checkSheetInvariants(t, ss)
+
+			for area, total := range before {
+				if got := ss.AreaTotal(area); got < total {
+					t.Fatalf("%s dropped from %d to %d after a mark", area, total, got)
+				}
+			}
+			if got := ss.GetTotalScore(); got < beforeTotal {
+				t.Fatalf("total dropped from %d to %d after a mark", beforeTotal, got)
+			}
+			if ss.Bonus.FoxCount < beforeFoxes {
+				t.Fatalf("foxes dropped from %d to %d after a mark", beforeFoxes, ss.Bonus.FoxCount)
+			}
+
+			// Undoing the mark on a copy restores the previous score
+			trial := ss.Clone()
+			if err := undo(trial); err != nil {
+				t.Fatalf("undo: %v", err)
+			}
+			checkSheetInvariants(t, trial)
+			if got := trial.GetTotalScore(); got != beforeTotal {
+				t.Fatalf("total after undo = %d, want %d", got, beforeTotal)
+			}
+		}
+	})
+}
+
+// FuzzFoxBonus checks the fox bonus formula: foxes times the lowest area, never negative
+func FuzzFoxBonus(f *testing.F) {
+	f.Add(0, 30, 28, 40, 25, 22)
+	f.Add(3, 30, 28, 40, 25, 0)
+	f.Add(5, 60, 66, 96, 66, 56)
+	f.Add(-2, 10, 10, 10, 10, 10)
+	f.Add(2, -5, 10, 12, 18, 20)
+
+	f.Fuzz(func(t *testing.T, foxes, yellow, green, orange, purple, blue int) {
+		// Keep the product in range; a sheet never gets near these bounds
+		foxes %= 1 << 16
+		sections := []int{yellow % (1 << 24), green % (1 << 24), orange % (1 << 24), purple % (1 << 24), blue % (1 << 24)}
+
+		got := FoxBonus(foxes, sections)
+		if got < 0 {
+			t.Fatalf("FoxBonus(%d, %v) = %d is negative", foxes, sections, got)
+		}
+		if foxes <= 0 {
+			if got != 0 {
+				t.Fatalf("FoxBonus(%d, %v) = %d without foxes", foxes, sections, got)
+			}
+			return
+		}
+
+		lowest := max(min(sections[0], sections[1], sections[2], sections[3], sections[4]), 0)
+		if got != foxes*lowest {
+			t.Fatalf("FoxBonus(%d, %v) = %d, want %d * %d", foxes, sections, got, foxes, lowest)
+		}
+		if more := FoxBonus(foxes+1, sections); more < got {
+			t.Fatalf("one more fox lowered the bonus from %d to %d", got, more)
+		}
+	})
+}
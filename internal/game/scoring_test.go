@@ -0,0 +1,176 @@
+package game
+
+import (
+	"testing"
+)
+
+// sheetMarks lists the boxes filled in on a score sheet
+type sheetMarks struct {
+	yellow [][2]int // row, column
+	green  []int
+	orange []int
+	purple []int
+	blue   []int // dice sums
+}
+
+// fill enters the marks on a fresh base game sheet in the order they are listed
+func (m sheetMarks) fill(t testing.TB) *ScoreSheet {
+	t.Helper()
+	ss := NewScoreSheet()
+	for _, box := range m.yellow {
+		if _, err := ss.MarkYellow(box[0], box[1]); err != nil {
+			t.Fatalf("MarkYellow(%d, %d): %v", box[0], box[1], err)
+		}
+	}
+	for _, value := range m.green {
+		if _, err := ss.MarkGreen(value); err != nil {
+			t.Fatalf("MarkGreen(%d): %v", value, err)
+		}
+	}
+	for _, value := range m.orange {
+		if _, err := ss.MarkOrange(value); err != nil {
+			t.Fatalf("MarkOrange(%d): %v", value, err)
+		}
+	}
+	for _, value := range m.purple {
+		if _, err := ss.MarkPurple(value); err != nil {
+			t.Fatalf("MarkPurple(%d): %v", value, err)
+		}
+	}
+	for _, sum := range m.blue {
+		if _, err := ss.MarkBlue(sum); err != nil {
+			t.Fatalf("MarkBlue(%d): %v", sum, err)
+		}
+	}
+	return ss
+}
+
+// allYellow lists every yellow box that is not crossed off before the game starts
+func allYellow() [][2]int {
+	var boxes [][2]int
+	for row := range YellowSize {
+		for col := range YellowSize {
+			if !YellowPreCrossed(row, col) {
+				boxes = append(boxes, [2]int{row, col})
+			}
+		}
+	}
+	return boxes
+}
+
+func TestKnownScoreSheets(t *testing.T) {
+	tests := []struct {
+		name                                string
+		marks                               sheetMarks
+		yellow, green, orange, purple, blue int
+		foxes, bonus, total                 int
+	}{
+		{
+			name: "empty sheet",
+		},
+		{
+			name: "typical game",
+			marks: sheetMarks{
+				yellow: [][2]int{{0, 0}, {1, 0}, {2, 0}, {0, 1}, {1, 1}, {3, 1}},
+				green:  []int{1, 2, 3, 4, 5, 1, 2},
+				orange: []int{3, 4, 5, 6, 2, 6, 3},
+				purple: []int{2, 4, 6, 1, 3, 5, 6, 2},
+				blue:   []int{2, 3, 4, 5, 6, 7},
+			},
+			yellow: 24, green: 28, orange: 38, purple: 29, blue: 16,
+			foxes: 2, bonus: 32, total: 167,
+		},
+		{
+			name: "fox with an empty area earns nothing",
+			marks: sheetMarks{
+				yellow: [][2]int{{3, 1}, {3, 2}, {3, 3}},
+				green:  []int{1, 2, 3, 4, 5, 1, 2},
+			},
+			green: 28,
+			foxes: 2, bonus: 0, total: 28,
+		},
+		{
+			name: "blue rows and columns",
+			marks: sheetMarks{
+				orange: []int{1},
+				green:  []int{1},
+				purple: []int{1},
+				yellow: [][2]int{{0, 0}, {1, 0}, {2, 0}},
+				blue:   []int{9, 10, 11, 12, 5},
+			},
+			yellow: 10, green: 1, orange: 1, purple: 1, blue: 11,
+			foxes: 1, bonus: 1, total: 25,
+		},
+		{
+			name: "full sheet",
+			marks: sheetMarks{
+				yellow: allYellow(),
+				green:  []int{1, 2, 3, 4, 5, 6, 2, 3, 4, 5, 6},
+				orange: []int{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+				purple: []int{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+				blue:   []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			},
+			yellow: 60, green: 66, orange: 96, purple: 66, blue: 56,
+			foxes: 5, bonus: 280, total: 624,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := tt.marks.fill(t)
+
+			areas := map[Color]int{
+				ColorYellow: tt.yellow,
+				ColorGreen:  tt.green,
+				ColorOrange: tt.orange,
+				ColorPurple: tt.purple,
+				ColorBlue:   tt.blue,
+			}
+			for area, want := range areas {
+				if got := ss.AreaTotal(area); got != want {
+					t.Errorf("%s = %d, want %d", area, got, want)
+				}
+			}
+			if ss.Bonus.FoxCount != tt.foxes {
+				t.Errorf("foxes = %d, want %d", ss.Bonus.FoxCount, tt.foxes)
+			}
+			if ss.Bonus.Total != tt.bonus {
+				t.Errorf("bonus = %d, want %d", ss.Bonus.Total, tt.bonus)
+			}
+			if got := ss.GetTotalScore(); got != tt.total {
+				t.Errorf("total = %d, want %d", got, tt.total)
+			}
+		})
+	}
+}
+
+func TestAreaScoreTables(t *testing.T) {
+	wantGreen := []int{0, 1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 66}
+	for count, want := range wantGreen {
+		green := &GreenScoreArea{Values: make([]int, count)}
+		if got := calculateGreenScore(green); got != want {
+			t.Errorf("green with %d boxes = %d, want %d", count, got, want)
+		}
+	}
+
+	wantBlue := []int{0, 1, 2, 4, 7, 11, 16, 22, 29, 37, 46, 56}
+	blue := NewBlueScoreArea()
+	for count, want := range wantBlue {
+		if count > 0 {
+			blue.Marks[BlueMinSum+count-1] = true
+		}
+		if got := calculateBlueScore(blue); got != want {
+			t.Errorf("blue with %d crosses = %d, want %d", count, got, want)
+		}
+	}
+
+	orange := &OrangeScoreArea{Values: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}}
+	if got := calculateOrangeScore(orange); got != 16 {
+		t.Errorf("orange of ones = %d, want the multiplier sum 16", got)
+	}
+
+	purple := &PurpleScoreArea{Values: []int{1, 3, 6, 2, 5}}
+	if got := calculatePurpleScore(purple); got != 17 {
+		t.Errorf("purple = %d, want 17", got)
+	}
+}
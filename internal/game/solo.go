@@ -0,0 +1,53 @@
+package game
+
+// SoloRating is a tier of the solo rating table: scores of at least Min earn the title
+type SoloRating struct {
+	Min   int
+	Title string
+}
+
+// soloRatings is the solo rating table of each edition, highest tier first, as printed in
+// the solo variant of the rulebook. Doppelt so clever's rulebook has no solo table, so it
+// gets no rating.
+var soloRatings = map[string][]SoloRating{
+	RulesetGanzSchoenClever: {
+		{281, "Genius"},
+		{241, "Very clever"},
+		{201, "Pretty clever"},
+		{161, "Not bad"},
+		{0, "Keep practising"},
+	},
+}
+
+// SoloRatings returns the rating table of an edition, highest tier first,
+// or nil when the edition has no solo ratings
+func SoloRatings(ruleset Ruleset) []SoloRating {
+	return soloRatings[ruleset.ID()]
+}
+
+// RateSolo returns the rating title for a solo score, or "" when the edition has no solo ratings
+func RateSolo(ruleset Ruleset, score int) string {
+	for _, rating := range SoloRatings(ruleset) {
+		if score >= rating.Min {
+			return rating.Title
+		}
+	}
+	return ""
+}
+
+// IsSolo reports whether the players make up a solo game
+func IsSolo(players []*Player) bool {
+	return len(players) == 1
+}
+
+// NewSoloPassiveTurn simulates the passive phase of a solo round: all six dice are rolled,
+// the three highest are discarded and the player picks one of the three left on the platter.
+// The turn is placed in the tracked round, so a bot's pick can look ahead; rounds may be nil.
+func NewSoloPassiveTurn(player *Player, rounds *RoundTracker, roller Roller) *Turn {
+	turn := NewPassiveTurn([]*Player{player}, roller)
+	if rounds != nil {
+		turn.Round = rounds.Round
+		turn.TotalRounds = rounds.TotalRounds
+	}
+	return turn
+}
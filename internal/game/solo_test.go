@@ -0,0 +1,49 @@
+package game
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSoloPassiveTurnKnowsTheRound(t *testing.T) {
+	player := NewBot("Bot", Greedy{}, DefaultRuleset())
+	rounds, err := NewRoundTracker([]*Player{player})
+	if err != nil {
+		t.Fatalf("NewRoundTracker: %v", err)
+	}
+	rounds.Start()
+
+	turn := NewSoloPassiveTurn(player, rounds, rand.New(rand.NewPCG(1, 0)))
+	situation := turn.Situation()
+	if situation.Round != rounds.Round || situation.TotalRounds != rounds.TotalRounds || situation.TotalRounds == 0 {
+		t.Errorf("solo passive situation = %+v, want round %d of %d", situation, rounds.Round, rounds.TotalRounds)
+	}
+	if got := len(turn.Available(player)); got != MaxActivePicks {
+		t.Errorf("solo platter has %d dice, want %d", got, MaxActivePicks)
+	}
+}
+
+func TestRateSolo(t *testing.T) {
+	tests := []struct {
+		score int
+		want  string
+	}{
+		{0, "Keep practising"},
+		{160, "Keep practising"},
+		{161, "Not bad"},
+		{200, "Not bad"},
+		{201, "Pretty clever"},
+		{241, "Very clever"},
+		{280, "Very clever"},
+		{281, "Genius"},
+		{400, "Genius"},
+	}
+	for _, tt := range tests {
+		if got := RateSolo(DefaultRuleset(), tt.score); got != tt.want {
+			t.Errorf("RateSolo(%d) = %q, want %q", tt.score, got, tt.want)
+		}
+	}
+	if got := RateSolo(RulesetByID(RulesetDoppeltSoClever), 300); got != "" {
+		t.Errorf("Doppelt so clever solo rating = %q, want none", got)
+	}
+}
@@ -0,0 +1,144 @@
+package game
+
+// rewardWorth is the rough number of points a greedy bot expects from each kind of reward
+var rewardWorth = map[RewardKind]float64{
+	RewardFox:      6,
+	RewardReroll:   2,
+	RewardPlusOne:  2,
+	RewardFreeMark: 4,
+}
+
+// Greedy takes the move worth the most right now: the points it scores plus a rough value
+// for the rewards it unlocks, less the dice it would send to the silver platter
+type Greedy struct{}
+
+func (Greedy) ID() string   { return StrategyGreedy }
+func (Greedy) Name() string { return "Greedy" }
+
+func (g Greedy) Choose(player *Player, turn *Turn, moves []Move) (Move, bool) {
+	var best Move
+	bestWorth := 0.0
+	for i, move := range moves {
+		worth := g.worth(player, turn, move)
+		if i == 0 || worth > bestWorth {
+			best, bestWorth = move, worth
+		}
+	}
+	return best, len(moves) > 0
+}
+
+// worth estimates the value of a move for the greedy heuristic
+func (Greedy) worth(player *Player, turn *Turn, move Move) float64 {
+	worth := float64(move.Points)
+	for _, reward := range move.Rewards {
+		worth += rewardWorth[reward.Kind]
+	}
+
+	// Dice lower than the pick are lost to the platter while the active player still has picks left
+	if turn != nil && move.Free == nil && player == turn.Active && len(turn.Picked)+1 < MaxActivePicks {
+		for _, die := range turn.Rolled {
+			if die != move.Die && turn.Dice[die] < turn.Dice[move.Die] {
+				worth--
+			}
+		}
+	}
+	return worth
+}
+
+// MonteCarlo makes each candidate move on a copy of the sheet, plays out the remaining
+// rounds many times with greedy picks on random dice, and takes the move with the best
+// average final score
+type MonteCarlo struct {
+	Rollouts int
+	roller   Roller
+}
+
+// NewMonteCarlo creates a Monte Carlo strategy that simulates with the given dice
+func NewMonteCarlo(roller Roller, rollouts int) *MonteCarlo {
+	return &MonteCarlo{Rollouts: max(rollouts, 1), roller: roller}
+}
+
+func (*MonteCarlo) ID() string   { return StrategyMonteCarlo }
+func (*MonteCarlo) Name() string { return "Monte Carlo" }
+
+func (mc *MonteCarlo) Choose(player *Player, turn *Turn, moves []Move) (Move, bool) {
+	if len(moves) <= 1 {
+		return Greedy{}.Choose(player, turn, moves)
+	}
+
+	var best Move
+	bestScore := -1.0
+	for _, move := range moves {
+		score, err := mc.Estimate(player, turn, move)
+		if err != nil {
+			// A move the simulation cannot play out is left to the greedy heuristic
+			return Greedy{}.Choose(player, turn, moves)
+		}
+		if score > bestScore {
+			best, bestScore = move, score
+		}
+	}
+	return best, true
+}
+
+// Estimate returns the average final score over the simulated games after making the move.
+// The rest of the turn is played out first, so dice the move sends to the silver platter
+// are no longer there for the player's later picks.
+func (mc *MonteCarlo) Estimate(player *Player, turn *Turn, move Move) (float64, error) {
+	return mc.estimate(player, turn, turn.Situation(), move)
+}
+
+func (mc *MonteCarlo) estimate(player *Player, turn *Turn, situation Situation, move Move) (float64, error) {
+	total := 0
+	for range mc.Rollouts {
+		score, err := mc.rollout(player, turn, situation, move)
+		if err != nil {
+			return 0, err
+		}
+		total += score
+	}
+	return float64(total) / float64(mc.Rollouts), nil
+}
+
+// rollout plays the rest of the game once on a copy of the player's sheet: the rest of the
+// current turn with greedy picks, then every later round with one active turn for the player
+// and one passive pick per opponent, from a simulated platter. turn may be nil when the move
+// is made outside of a tracked turn.
+func (mc *MonteCarlo) rollout(player *Player, turn *Turn, situation Situation, move Move) (int, error) {
+	sim := &Player{Name: player.Name, ScoreSheet: player.ScoreSheet.Clone(), Bot: Greedy{}}
+	simTurn := turn.simulate(player, sim, mc.roller)
+
+	var rewards []Reward
+	var err error
+	if simTurn != nil && move.Free == nil {
+		rewards, err = simTurn.Pick(sim, move.Placement)
+	} else {
+		rewards, err = sim.ScoreSheet.ApplyMove(move)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if _, err := PlaceFreeMarks(sim, simTurn, rewards); err != nil {
+		return 0, err
+	}
+	if simTurn != nil {
+		if _, err := PlayBot(simTurn, sim); err != nil {
+			return 0, err
+		}
+	}
+
+	for round := situation.Round + 1; round <= situation.TotalRounds; round++ {
+		if _, err := PlaceFreeMarks(sim, nil, sim.ScoreSheet.StartRound(round)); err != nil {
+			return 0, err
+		}
+		if _, err := PlayBot(NewTurn(sim, nil, mc.roller), sim); err != nil {
+			return 0, err
+		}
+		for range situation.Opponents {
+			if _, err := PlayBot(NewPassiveTurn([]*Player{sim}, mc.roller), sim); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return sim.GetTotalScore(), nil
+}
@@ -0,0 +1,48 @@
+package game
+
+import (
+	"errors"
+	"maps"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestMonteCarloLeavesTheTurnAlone(t *testing.T) {
+	mc := NewMonteCarlo(rand.New(rand.NewPCG(3, 0)), 8)
+	bot := NewBot("Bot", mc, DefaultRuleset())
+	turn := NewTurn(bot, nil, rand.New(rand.NewPCG(4, 0)))
+	dice := maps.Clone(turn.Dice)
+	moves := turn.LegalMoves(bot)
+	if len(moves) == 0 {
+		t.Fatal("no legal moves on an empty sheet")
+	}
+
+	for _, move := range moves {
+		if _, err := mc.Estimate(bot, turn, move); err != nil {
+			t.Fatalf("Estimate(%s): %v", move, err)
+		}
+	}
+	if len(turn.Picked) != 0 || len(turn.Platter) != 0 || len(turn.Rolled) != len(diceColors) || turn.Phase != PhaseActive {
+		t.Errorf("simulating changed the turn: picked %v, platter %v, rolled %v", turn.Picked, turn.Platter, turn.Rolled)
+	}
+	for color, value := range dice {
+		if turn.Dice[color] != value {
+			t.Errorf("simulating rerolled the %s die", color)
+		}
+	}
+	if bot.GetTotalScore() != 0 {
+		t.Errorf("simulating scored %d on the bot's own sheet", bot.GetTotalScore())
+	}
+}
+
+func TestMonteCarloReportsIllegalMoves(t *testing.T) {
+	mc := NewMonteCarlo(rand.New(rand.NewPCG(3, 0)), 4)
+	bot := NewBot("Bot", mc, DefaultRuleset())
+	turn := NewTurn(bot, nil, rand.New(rand.NewPCG(4, 0)))
+
+	// The next green box is the first one, so the fifth cannot be marked
+	move := Move{Placement: Placement{Die: ColorGreen, Area: ColorGreen, Box: 4}, dice: turn.Dice}
+	if _, err := mc.Estimate(bot, turn, move); !errors.Is(err, ErrWrongBox) {
+		t.Errorf("Estimate error = %v, want ErrWrongBox", err)
+	}
+}
@@ -0,0 +1,323 @@
+package game
+
+import (
+	"errors"
+	"maps"
+	"slices"
+)
+
+// MaxActivePicks is the number of dice the active player may take in one turn
+const MaxActivePicks = 3
+
+var (
+	// ErrDieNotAvailable is returned when a die is not in the roll or on the platter
+	ErrDieNotAvailable = errors.New("die is not available")
+	// ErrNotInTurn is returned when a player takes no part in the turn
+	ErrNotInTurn = errors.New("player is not part of this turn")
+	// ErrWrongPhase is returned when a player acts outside their phase of the turn
+	ErrWrongPhase = errors.New("player cannot act in this phase of the turn")
+	// ErrAlreadyPicked is returned when a passive player picks a second time
+	ErrAlreadyPicked = errors.New("player has already picked")
+	// ErrTurnOver is returned when acting on a finished turn
+	ErrTurnOver = errors.New("turn is over")
+)
+
+// TurnPhase is the part of a turn currently being played
+type TurnPhase int
+
+const (
+	// PhaseActive is the active player rolling and picking up to three dice
+	PhaseActive TurnPhase = iota
+	// PhasePassive is the passive players picking from the silver platter
+	PhasePassive
+	// PhaseDone is a finished turn
+	PhaseDone
+)
+
+// Turn plays one turn with real dice: the active player rolls and picks up to three dice,
+// every die lower than a pick goes to the silver platter, and once the active player is done
+// each passive player picks one die from the platter.
+type Turn struct {
+	Active  *Player
+	Passive []*Player
+	Phase   TurnPhase
+
+	// Round and TotalRounds place the turn in the game when it is started by a RoundTracker
+	Round       int
+	TotalRounds int
+
+	Dice    Dice    // current face value of all six dice
+	Rolled  []Color // dice still in front of the active player
+	Picked  []Color // dice taken by the active player, in order
+	Platter []Color // dice on the silver platter
+
+	passiveDone map[*Player]bool
+	roller      Roller
+}
+
+// NewTurn starts a turn and rolls all six dice for the active player
+func NewTurn(active *Player, passive []*Player, roller Roller) *Turn {
+	t := &Turn{
+		Active:      active,
+		Passive:     passive,
+		Dice:        make(Dice, len(diceColors)),
+		Rolled:      DiceColors(),
+		passiveDone: make(map[*Player]bool, len(passive)),
+		roller:      roller,
+	}
+	t.Dice.Roll(roller, t.Rolled)
+	return t
+}
+
+// NewPassiveTurn starts a turn whose active player is not tracked, such as a human rolling
+// real dice the app never sees. All six dice are rolled and the lowest MaxActivePicks of them
+// stand in for the dice the active player would leave on the silver platter.
+func NewPassiveTurn(passive []*Player, roller Roller) *Turn {
+	t := NewTurn(nil, passive, roller)
+	slices.SortStableFunc(t.Rolled, func(a, b Color) int {
+		return t.Dice[a] - t.Dice[b]
+	})
+	t.Rolled = t.Rolled[:MaxActivePicks]
+	t.endActive()
+	return t
+}
+
+// Available returns the dice a player may pick from right now:
+// the rolled dice for the active player, the platter for a passive player
+func (t *Turn) Available(player *Player) []Color {
+	switch {
+	case t.Phase == PhaseActive && player == t.Active:
+		return slices.Clone(t.Rolled)
+	case t.Phase == PhasePassive && t.isPassive(player) && !t.passiveDone[player]:
+		return slices.Clone(t.Platter)
+	default:
+		return nil
+	}
+}
+
+// Reroll spends one of the active player's rerolls to roll the remaining dice again
+func (t *Turn) Reroll() error {
+	if t.Phase != PhaseActive {
+		return ErrWrongPhase
+	}
+	if err := t.Active.ScoreSheet.Rerolls.Use(); err != nil {
+		return err
+	}
+	t.Dice.Roll(t.roller, t.Rolled)
+	return nil
+}
+
+// Pick enters an available die on the player's sheet and returns the rewards it unlocked.
+// For the active player lower dice go to the silver platter and the rest are rolled again.
+func (t *Turn) Pick(player *Player, p Placement) ([]Reward, error) {
+	if err := t.checkPick(player, p); err != nil {
+		return nil, err
+	}
+
+	rewards, err := player.ScoreSheet.Place(p, t.Dice)
+	if err != nil {
+		return nil, err
+	}
+
+	if player == t.Active {
+		t.takeActive(p.Die)
+	} else {
+		t.passiveDone[player] = true
+		t.advancePassive()
+	}
+	return rewards, nil
+}
+
+// Pass lets a player give up the rest of their picks: the active player ends their picks
+// and the remaining dice go to the platter, a passive player takes nothing this turn
+func (t *Turn) Pass(player *Player) error {
+	if t.Phase == PhaseDone {
+		return ErrTurnOver
+	}
+
+	switch {
+	case player == t.Active:
+		if t.Phase != PhaseActive {
+			return ErrWrongPhase
+		}
+		t.endActive()
+	case t.isPassive(player):
+		if t.Phase != PhasePassive {
+			return ErrWrongPhase
+		}
+		if t.passiveDone[player] {
+			return ErrAlreadyPicked
+		}
+		t.passiveDone[player] = true
+		t.advancePassive()
+	default:
+		return ErrNotInTurn
+	}
+	return nil
+}
+
+// Waiting returns the passive players who still have to pick from the platter
+func (t *Turn) Waiting() []*Player {
+	if t.Phase != PhasePassive {
+		return nil
+	}
+	var waiting []*Player
+	for _, player := range t.Passive {
+		if !t.passiveDone[player] {
+			waiting = append(waiting, player)
+		}
+	}
+	return waiting
+}
+
+// Done reports whether every player has finished the turn
+func (t *Turn) Done() bool {
+	return t.Phase == PhaseDone
+}
+
+// checkPick validates that the player may take the die now, before the sheet checks the box
+func (t *Turn) checkPick(player *Player, p Placement) error {
+	if t.Phase == PhaseDone {
+		return ErrTurnOver
+	}
+	if player != t.Active && !t.isPassive(player) {
+		return ErrNotInTurn
+	}
+	if player == t.Active && t.Phase != PhaseActive {
+		return ErrWrongPhase
+	}
+	if player != t.Active {
+		if t.Phase != PhasePassive {
+			return ErrWrongPhase
+		}
+		if t.passiveDone[player] {
+			return ErrAlreadyPicked
+		}
+	}
+	if !slices.Contains(t.Available(player), p.Die) {
+		return ErrDieNotAvailable
+	}
+	return nil
+}
+
+// takeActive sets the picked die aside, moves lower dice to the platter and rolls the rest
+func (t *Turn) takeActive(die Color) {
+	value := t.Dice[die]
+	t.Picked = append(t.Picked, die)
+
+	remaining := t.Rolled[:0]
+	for _, color := range t.Rolled {
+		switch {
+		case color == die:
+		case t.Dice[color] < value:
+			t.Platter = append(t.Platter, color)
+		default:
+			remaining = append(remaining, color)
+		}
+	}
+	t.Rolled = remaining
+
+	if len(t.Picked) >= MaxActivePicks || len(t.Rolled) == 0 {
+		t.endActive()
+		return
+	}
+	t.Dice.Roll(t.roller, t.Rolled)
+}
+
+// endActive moves the dice left in the roll to the platter and hands over to the passive players
+func (t *Turn) endActive() {
+	t.Platter = append(t.Platter, t.Rolled...)
+	t.Rolled = nil
+	t.Phase = PhasePassive
+	t.advancePassive()
+}
+
+// advancePassive ends the turn once every passive player has picked or passed
+func (t *Turn) advancePassive() {
+	if len(t.Waiting()) == 0 {
+		t.Phase = PhaseDone
+	}
+}
+
+// canAct reports whether the player still has something to do in the turn
+func (t *Turn) canAct(player *Player) bool {
+	switch t.Phase {
+	case PhaseActive:
+		return player == t.Active
+	case PhasePassive:
+		return t.isPassive(player) && !t.passiveDone[player]
+	default:
+		return false
+	}
+}
+
+// simulate copies the player's part of the turn for a simulated player, who takes the
+// player's place and rolls with the given dice. The other players are left out.
+// It returns nil for a nil turn.
+func (t *Turn) simulate(player, sim *Player, roller Roller) *Turn {
+	if t == nil {
+		return nil
+	}
+	copied := &Turn{
+		Phase:       t.Phase,
+		Round:       t.Round,
+		TotalRounds: t.TotalRounds,
+		Dice:        maps.Clone(t.Dice),
+		Rolled:      slices.Clone(t.Rolled),
+		Picked:      slices.Clone(t.Picked),
+		Platter:     slices.Clone(t.Platter),
+		passiveDone: make(map[*Player]bool),
+		roller:      roller,
+	}
+	switch {
+	case player == t.Active:
+		copied.Active = sim
+	case t.isPassive(player):
+		copied.Passive = []*Player{sim}
+		copied.passiveDone[sim] = t.passiveDone[player]
+	}
+	return copied
+}
+
+func (t *Turn) isPassive(player *Player) bool {
+	return slices.Contains(t.Passive, player)
+}
+
+// PlayTurn starts a dice turn for the active player, with every other player passive
+func (rt *RoundTracker) PlayTurn(roller Roller) (*Turn, error) {
+	active := rt.ActivePlayer()
+	if active == nil {
+		return nil, ErrGameOver
+	}
+	var passive []*Player
+	for _, player := range rt.Players {
+		if player != active {
+			passive = append(passive, player)
+		}
+	}
+	turn := NewTurn(active, passive, roller)
+	turn.Round = rt.Round
+	turn.TotalRounds = rt.TotalRounds
+	return turn, nil
+}
+
+// Situation places a turn in the game: the round being played and how many opponents are left
+// to pick from platters in later rounds
+type Situation struct {
+	Round       int // current round, 0 when rounds are not tracked
+	TotalRounds int
+	Opponents   int
+}
+
+// Situation describes where the turn stands in the game; a nil turn is outside of any game
+func (t *Turn) Situation() Situation {
+	if t == nil {
+		return Situation{}
+	}
+	return Situation{Round: t.Round, TotalRounds: t.TotalRounds, Opponents: len(t.Passive)}
+}
+
+// Situation describes where the tracked game stands for any one of its players
+func (rt *RoundTracker) Situation() Situation {
+	return Situation{Round: rt.Round, TotalRounds: rt.TotalRounds, Opponents: len(rt.Players) - 1}
+}
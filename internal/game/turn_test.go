@@ -0,0 +1,225 @@
+package game
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// fixedRoller rolls the listed values in order and sixes once they run out
+type fixedRoller struct {
+	values []int
+}
+
+func (r *fixedRoller) IntN(n int) int {
+	if len(r.values) == 0 {
+		return n - 1
+	}
+	value := r.values[0]
+	r.values = r.values[1:]
+	return value - 1
+}
+
+// rollDice returns a roller for one roll of all six dice, in the order they are rolled:
+// white, yellow, blue, green, orange and purple
+func rollDice(white, yellow, blue, green, orange, purple int, rerolls ...int) *fixedRoller {
+	return &fixedRoller{values: append([]int{white, yellow, blue, green, orange, purple}, rerolls...)}
+}
+
+// inOwnArea places a die in its own area, in the next box
+func inOwnArea(ss *ScoreSheet, die Color) Placement {
+	p := Placement{Die: die, Area: die}
+	switch die {
+	case ColorGreen:
+		p.Box = ss.Green.NextBox()
+	case ColorOrange:
+		p.Box = ss.Orange.NextSpace()
+	case ColorPurple:
+		p.Box = ss.Purple.NextSpace()
+	}
+	return p
+}
+
+func sameDice(a, b []Color) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func TestPickMovesLowerDiceToPlatter(t *testing.T) {
+	active := NewPlayer("Active")
+	passive := NewPlayer("Passive")
+	turn := NewTurn(active, []*Player{passive}, rollDice(1, 2, 3, 4, 5, 6, 3))
+
+	if _, err := turn.Pick(active, inOwnArea(active.ScoreSheet, ColorOrange)); err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	if want := []Color{ColorWhite, ColorYellow, ColorBlue, ColorGreen}; !sameDice(turn.Platter, want) {
+		t.Errorf("platter = %v, want the dice lower than orange %v", turn.Platter, want)
+	}
+	if !slices.Equal(turn.Rolled, []Color{ColorPurple}) || turn.Dice[ColorPurple] != 3 {
+		t.Errorf("rolled = %v with purple %d, want purple rolled again to 3", turn.Rolled, turn.Dice[ColorPurple])
+	}
+	if !slices.Equal(turn.Picked, []Color{ColorOrange}) || active.ScoreSheet.Orange.NextSpace() != 1 {
+		t.Errorf("picked = %v, want orange entered on the sheet", turn.Picked)
+	}
+
+	// Taking the last die ends the active phase
+	if _, err := turn.Pick(active, inOwnArea(active.ScoreSheet, ColorPurple)); err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	if turn.Phase != PhasePassive || len(turn.Rolled) != 0 {
+		t.Errorf("phase = %v with %v rolled, want the passive phase once no dice are left", turn.Phase, turn.Rolled)
+	}
+}
+
+func TestActivePlayerPicksAtMostThreeDice(t *testing.T) {
+	active := NewPlayer("Active")
+	passive := NewPlayer("Passive")
+	// Equal dice are not lower than the pick, so none go to the platter
+	turn := NewTurn(active, []*Player{passive}, rollDice(1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1))
+
+	for i, die := range []Color{ColorOrange, ColorPurple, ColorGreen} {
+		if turn.Phase != PhaseActive {
+			t.Fatalf("phase = %v before pick %d, want active", turn.Phase, i+1)
+		}
+		if _, err := turn.Pick(active, inOwnArea(active.ScoreSheet, die)); err != nil {
+			t.Fatalf("Pick %d: %v", i+1, err)
+		}
+	}
+	if len(turn.Picked) != MaxActivePicks || turn.Phase != PhasePassive {
+		t.Errorf("picked %v in phase %v, want %d picks and the passive phase", turn.Picked, turn.Phase, MaxActivePicks)
+	}
+	if want := []Color{ColorWhite, ColorYellow, ColorBlue}; !sameDice(turn.Platter, want) {
+		t.Errorf("platter = %v, want the unpicked dice %v", turn.Platter, want)
+	}
+	if turn.Available(active) != nil {
+		t.Errorf("active player can still pick %v", turn.Available(active))
+	}
+}
+
+func TestPassivePicksComeFromThePlatter(t *testing.T) {
+	active := NewPlayer("Active")
+	first, second := NewPlayer("First"), NewPlayer("Second")
+	turn := NewTurn(active, []*Player{first, second}, rollDice(1, 2, 3, 4, 5, 6, 2))
+
+	if _, err := turn.Pick(first, inOwnArea(first.ScoreSheet, ColorWhite)); !errors.Is(err, ErrWrongPhase) {
+		t.Errorf("passive pick while the active player rolls: error = %v, want ErrWrongPhase", err)
+	}
+	if _, err := turn.Pick(active, inOwnArea(active.ScoreSheet, ColorOrange)); err != nil {
+		t.Fatalf("Pick: %v", err)
+	}
+	if err := turn.Pass(active); err != nil {
+		t.Fatalf("Pass: %v", err)
+	}
+	if !sameDice(turn.Available(first), turn.Platter) {
+		t.Errorf("passive player may pick %v, want the platter %v", turn.Available(first), turn.Platter)
+	}
+
+	if _, err := turn.Pick(first, inOwnArea(first.ScoreSheet, ColorOrange)); !errors.Is(err, ErrDieNotAvailable) {
+		t.Errorf("passive pick of the active player's die: error = %v, want ErrDieNotAvailable", err)
+	}
+	if _, err := turn.Pick(active, inOwnArea(active.ScoreSheet, ColorGreen)); !errors.Is(err, ErrWrongPhase) {
+		t.Errorf("active pick in the passive phase: error = %v, want ErrWrongPhase", err)
+	}
+	if err := turn.Reroll(); !errors.Is(err, ErrWrongPhase) {
+		t.Errorf("reroll in the passive phase: error = %v, want ErrWrongPhase", err)
+	}
+	if _, err := turn.Pick(NewPlayer("Stranger"), inOwnArea(first.ScoreSheet, ColorGreen)); !errors.Is(err, ErrNotInTurn) {
+		t.Errorf("pick by a player outside the turn: error = %v, want ErrNotInTurn", err)
+	}
+
+	if _, err := turn.Pick(first, inOwnArea(first.ScoreSheet, ColorGreen)); err != nil {
+		t.Fatalf("passive Pick: %v", err)
+	}
+	if _, err := turn.Pick(first, inOwnArea(first.ScoreSheet, ColorYellow)); !errors.Is(err, ErrAlreadyPicked) {
+		t.Errorf("second passive pick: error = %v, want ErrAlreadyPicked", err)
+	}
+	if !slices.Equal(turn.Waiting(), []*Player{second}) {
+		t.Errorf("waiting = %v, want only the second passive player", turn.Waiting())
+	}
+
+	// Passive players may take the same platter die
+	if _, err := turn.Pick(second, inOwnArea(second.ScoreSheet, ColorGreen)); err != nil {
+		t.Fatalf("passive Pick: %v", err)
+	}
+	if !turn.Done() {
+		t.Error("turn is not done once every passive player has picked")
+	}
+	if _, err := turn.Pick(second, inOwnArea(second.ScoreSheet, ColorBlue)); !errors.Is(err, ErrTurnOver) {
+		t.Errorf("pick after the turn: error = %v, want ErrTurnOver", err)
+	}
+}
+
+func TestRerollSpendsAnAction(t *testing.T) {
+	active := NewPlayer("Active")
+	turn := NewTurn(active, nil, rollDice(1, 2, 3, 4, 5, 6, 6, 5, 4, 3, 2, 1))
+
+	if err := turn.Reroll(); !errors.Is(err, ErrNoActionAvailable) {
+		t.Errorf("reroll without one earned: error = %v, want ErrNoActionAvailable", err)
+	}
+	active.ScoreSheet.Rerolls.Grant()
+	if err := turn.Reroll(); err != nil {
+		t.Fatalf("Reroll: %v", err)
+	}
+	if active.ScoreSheet.Rerolls.Available() != 0 || active.ScoreSheet.Rerolls.Used != 1 {
+		t.Errorf("rerolls available %d, used %d, want 0 and 1", active.ScoreSheet.Rerolls.Available(), active.ScoreSheet.Rerolls.Used)
+	}
+	want := Dice{ColorWhite: 6, ColorYellow: 5, ColorBlue: 4, ColorGreen: 3, ColorOrange: 2, ColorPurple: 1}
+	for color, value := range want {
+		if turn.Dice[color] != value {
+			t.Errorf("%s die = %d after the reroll, want %d", color, turn.Dice[color], value)
+		}
+	}
+}
+
+func TestPass(t *testing.T) {
+	active := NewPlayer("Active")
+	first, second := NewPlayer("First"), NewPlayer("Second")
+	turn := NewTurn(active, []*Player{first, second}, rollDice(1, 2, 3, 4, 5, 6))
+
+	if err := turn.Pass(first); !errors.Is(err, ErrWrongPhase) {
+		t.Errorf("passive pass while the active player rolls: error = %v, want ErrWrongPhase", err)
+	}
+	if err := turn.Pass(active); err != nil {
+		t.Fatalf("active Pass: %v", err)
+	}
+	if len(turn.Platter) != len(diceColors) || len(turn.Rolled) != 0 || turn.Phase != PhasePassive {
+		t.Errorf("platter = %v in phase %v, want every die on the platter for the passive players", turn.Platter, turn.Phase)
+	}
+	if err := turn.Pass(active); !errors.Is(err, ErrWrongPhase) {
+		t.Errorf("active pass in the passive phase: error = %v, want ErrWrongPhase", err)
+	}
+
+	if err := turn.Pass(first); err != nil {
+		t.Fatalf("passive Pass: %v", err)
+	}
+	if err := turn.Pass(first); !errors.Is(err, ErrAlreadyPicked) {
+		t.Errorf("second passive pass: error = %v, want ErrAlreadyPicked", err)
+	}
+	if first.GetTotalScore() != 0 {
+		t.Errorf("passing scored %d", first.GetTotalScore())
+	}
+	if err := turn.Pass(second); err != nil {
+		t.Fatalf("passive Pass: %v", err)
+	}
+	if !turn.Done() {
+		t.Error("turn is not done once every passive player has passed")
+	}
+	if err := turn.Pass(second); !errors.Is(err, ErrTurnOver) {
+		t.Errorf("pass after the turn: error = %v, want ErrTurnOver", err)
+	}
+}
+
+func TestPassiveTurnOffersTheLowestDice(t *testing.T) {
+	passive := NewPlayer("Passive")
+	turn := NewPassiveTurn([]*Player{passive}, rollDice(4, 6, 1, 5, 2, 3))
+
+	if turn.Phase != PhasePassive {
+		t.Fatalf("phase = %v, want passive", turn.Phase)
+	}
+	if want := []Color{ColorBlue, ColorOrange, ColorPurple}; !sameDice(turn.Available(passive), want) {
+		t.Errorf("platter = %v, want the three lowest dice %v", turn.Available(passive), want)
+	}
+}
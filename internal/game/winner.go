@@ -0,0 +1,64 @@
+package game
+
+// Standing is what decides the winner for one player: the final score and each area score
+type Standing struct {
+	Total int
+	Areas []int
+}
+
+// StandingOf returns the standing of a player from their sheet
+func StandingOf(player *Player) Standing {
+	sheet := player.ScoreSheet
+	areas := sheet.Ruleset.Areas()
+	standing := Standing{Total: sheet.GetTotalScore(), Areas: make([]int, 0, len(areas))}
+	for _, area := range areas {
+		standing.Areas = append(standing.Areas, sheet.AreaTotal(area))
+	}
+	return standing
+}
+
+// bestArea returns the highest single area score of a standing
+func (s Standing) bestArea() int {
+	best := 0
+	for _, score := range s.Areas {
+		best = max(best, score)
+	}
+	return best
+}
+
+// ResolveWinners returns the indexes of the winning standings, in order. The highest total
+// wins; on a tie the tied player with the highest single area score wins, and players still
+// tied after that share the win.
+func ResolveWinners(standings []Standing) []int {
+	var winners []int
+	for i, standing := range standings {
+		if len(winners) == 0 {
+			winners = []int{i}
+			continue
+		}
+		leader := standings[winners[0]]
+		switch {
+		case standing.Total > leader.Total:
+			winners = []int{i}
+		case standing.Total < leader.Total:
+		case standing.bestArea() > leader.bestArea():
+			winners = []int{i}
+		case standing.bestArea() == leader.bestArea():
+			winners = append(winners, i)
+		}
+	}
+	return winners
+}
+
+// Winners returns the players who won, more than one on a shared win
+func Winners(players []*Player) []*Player {
+	standings := make([]Standing, len(players))
+	for i, player := range players {
+		standings[i] = StandingOf(player)
+	}
+	winners := make([]*Player, 0, 1)
+	for _, i := range ResolveWinners(standings) {
+		winners = append(winners, players[i])
+	}
+	return winners
+}
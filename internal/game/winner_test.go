@@ -0,0 +1,40 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResolveWinners(t *testing.T) {
+	tests := []struct {
+		name      string
+		standings []Standing
+		expected  []int
+	}{
+		{"no players", nil, nil},
+		{"single player", []Standing{{Total: 80, Areas: []int{10, 20}}}, []int{0}},
+		{"highest total wins", []Standing{{Total: 80}, {Total: 120}, {Total: 95}}, []int{1}},
+		{"tie broken by highest area", []Standing{
+			{Total: 100, Areas: []int{40, 30, 30}},
+			{Total: 100, Areas: []int{20, 50, 30}},
+		}, []int{1}},
+		{"tie on best area is shared", []Standing{
+			{Total: 100, Areas: []int{50, 30, 20}},
+			{Total: 90, Areas: []int{60, 30}},
+			{Total: 100, Areas: []int{25, 25, 50}},
+		}, []int{0, 2}},
+		{"lower total ignores better area", []Standing{
+			{Total: 100, Areas: []int{30, 30}},
+			{Total: 99, Areas: []int{99}},
+		}, []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ResolveWinners(tt.standings)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("ResolveWinners() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package game
+
+// YellowSize is the number of rows and columns in the yellow grid
+const YellowSize = 4
+
+// yellowNumbers is the printed yellow grid, row by row.
+// A zero marks the boxes on the diagonal that are crossed off before the game starts.
+var yellowNumbers = [YellowSize][YellowSize]int{
+	{3, 6, 5, 0},
+	{2, 1, 0, 5},
+	{1, 0, 2, 4},
+	{0, 3, 4, 6},
+}
+
+// yellowColumnPoints are the points printed below each completed yellow column
+var yellowColumnPoints = [YellowSize]int{10, 14, 16, 20}
+
+type YellowScoreArea struct {
+	Total int
+	Marks [YellowSize][YellowSize]bool // [row][col], pre-crossed boxes are always marked
+}
+
+// NewYellowScoreArea creates a yellow grid with the printed diagonal already crossed off
+func NewYellowScoreArea() *YellowScoreArea {
+	ysa := &YellowScoreArea{}
+	for row := range YellowSize {
+		for col := range YellowSize {
+			ysa.Marks[row][col] = YellowPreCrossed(row, col)
+		}
+	}
+	return ysa
+}
+
+func (ysa *YellowScoreArea) Record(score int) {
+	ysa.Total = score
+}
+
+// YellowNumber returns the die value printed in a yellow box, or 0 for a pre-crossed box
+func YellowNumber(row, col int) int {
+	if !yellowInRange(row, col) {
+		return 0
+	}
+	return yellowNumbers[row][col]
+}
+
+// YellowPreCrossed reports whether a yellow box is crossed off on a fresh sheet
+func YellowPreCrossed(row, col int) bool {
+	return yellowInRange(row, col) && yellowNumbers[row][col] == 0
+}
+
+// YellowColumnPoints returns the points awarded for completing a yellow column
+func YellowColumnPoints(col int) int {
+	if col < 0 || col >= YellowSize {
+		return 0
+	}
+	return yellowColumnPoints[col]
+}
+
+// Mark crosses off a yellow box and updates the total from the marks
+func (ysa *YellowScoreArea) Mark(row, col int) error {
+	if !yellowInRange(row, col) || YellowPreCrossed(row, col) {
+		return ErrBoxOutOfRange
+	}
+	if ysa.Marks[row][col] {
+		return ErrAlreadyMarked
+	}
+	ysa.Marks[row][col] = true
+	ysa.Total = calculateYellowScore(ysa)
+	return nil
+}
+
+// Unmark removes a cross from a yellow box and updates the total from the marks
+func (ysa *YellowScoreArea) Unmark(row, col int) error {
+	if !yellowInRange(row, col) || YellowPreCrossed(row, col) {
+		return ErrBoxOutOfRange
+	}
+	if !ysa.Marks[row][col] {
+		return ErrNotMarked
+	}
+	ysa.Marks[row][col] = false
+	ysa.Total = calculateYellowScore(ysa)
+	return nil
+}
+
+// IsMarked reports whether a yellow box is crossed off
+func (ysa *YellowScoreArea) IsMarked(row, col int) bool {
+	return yellowInRange(row, col) && ysa.Marks[row][col]
+}
+
+// ColumnComplete reports whether every box in a yellow column is crossed off
+func (ysa *YellowScoreArea) ColumnComplete(col int) bool {
+	if col < 0 || col >= YellowSize {
+		return false
+	}
+	for row := range YellowSize {
+		if !ysa.Marks[row][col] {
+			return false
+		}
+	}
+	return true
+}
+
+// RowComplete reports whether every box in a yellow row is crossed off
+func (ysa *YellowScoreArea) RowComplete(row int) bool {
+	if row < 0 || row >= YellowSize {
+		return false
+	}
+	for col := range YellowSize {
+		if !ysa.Marks[row][col] {
+			return false
+		}
+	}
+	return true
+}
+
+// DiagonalComplete reports whether the top-left to bottom-right diagonal is crossed off.
+// Completing it earns the diagonal bonus printed at the end of the grid.
+func (ysa *YellowScoreArea) DiagonalComplete() bool {
+	for i := range YellowSize {
+		if !ysa.Marks[i][i] {
+			return false
+		}
+	}
+	return true
+}
+
+func yellowInRange(row, col int) bool {
+	return row >= 0 && row < YellowSize && col >= 0 && col < YellowSize
+}
+
+func calculateYellowScore(yellow *YellowScoreArea) int {
+	score := 0
+	for col := range YellowSize {
+		if yellow.ColumnComplete(col) {
+			score += yellowColumnPoints[col]
+		}
+	}
+	return score
+}
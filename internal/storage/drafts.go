@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+// Draft is the checkpoint of a game that has not been finished yet.
+// There is at most one draft, the game currently being played.
+type Draft struct {
+	State     *game.GameState
+	UpdatedAt time.Time
+}
+
+// SaveDraft checkpoints the game in progress, replacing any earlier draft
+func (d *Database) SaveDraft(state *game.GameState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode draft: %w", err)
+	}
+
+	_, err = d.DB.Exec(`
+		INSERT INTO drafts (id, state, updated_at) VALUES (1, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET state = excluded.state, updated_at = excluded.updated_at
+	`, string(data), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to save draft: %w", err)
+	}
+	return nil
+}
+
+// LoadDraft returns the game in progress, or nil when there is none
+func (d *Database) LoadDraft() (*Draft, error) {
+	var data string
+	var draft Draft
+	err := d.DB.QueryRow("SELECT state, updated_at FROM drafts WHERE id = 1").Scan(&data, &draft.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load draft: %w", err)
+	}
+
+	draft.State = &game.GameState{}
+	if err := json.Unmarshal([]byte(data), draft.State); err != nil {
+		return nil, fmt.Errorf("failed to decode draft: %w", err)
+	}
+	return &draft, nil
+}
+
+// ClearDraft removes the game in progress once it is saved or discarded
+func (d *Database) ClearDraft() error {
+	if _, err := d.DB.Exec("DELETE FROM drafts"); err != nil {
+		return fmt.Errorf("failed to clear draft: %w", err)
+	}
+	return nil
+}
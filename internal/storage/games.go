@@ -2,8 +2,10 @@ package storage
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"thats-pretty-clever-scorer/internal/game"
 	"time"
 )
 
@@ -17,9 +19,9 @@ func (d *Database) SaveGame(session *GameSession) error {
 
 	// Insert game record
 	gameResult, err := tx.Exec(`
-		INSERT INTO games (uuid, created_at, completed_at, player_count, winner_name, winner_score, notes)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
-	`, session.ID, session.CreatedAt, session.CompletedAt, len(session.Players), session.GetWinnerName(), session.GetWinnerScore(), session.Notes)
+		INSERT INTO games (uuid, created_at, completed_at, player_count, winner_name, winner_score, notes, ruleset, solo_rating)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, session.ID, session.CreatedAt, session.CompletedAt, len(session.Players), session.GetWinnerName(), session.GetWinnerScore(), session.Notes, game.RulesetByID(session.Ruleset).ID(), session.SoloRating)
 
 	if err != nil {
 		return fmt.Errorf("failed to insert game: %w", err)
@@ -30,29 +32,41 @@ func (d *Database) SaveGame(session *GameSession) error {
 		return fmt.Errorf("failed to get game ID: %w", err)
 	}
 
-	// Insert players with section totals
+	// Insert players with section totals, each linked to the person their name refers to
+	personIDs := make(map[*Player]sql.NullInt64)
 	for _, player := range session.Players {
 		player.GameID = int(gameID) // Update the game ID for each player
 
-		_, err := tx.Exec(`
+		personID, err := resolvePerson(tx, player.Name)
+		if err != nil {
+			return err
+		}
+		personIDs[player] = personID
+
+		sheet, err := encodeSheet(player.Sheet)
+		if err != nil {
+			return fmt.Errorf("failed to encode sheet of %s: %w", player.Name, err)
+		}
+
+		_, err = tx.Exec(`
 			INSERT INTO players (game_id, name, final_score, winner, 
-				yellow_total, green_total, orange_total, purple_total, blue_total, fox_count, bonus)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+				yellow_total, green_total, orange_total, purple_total, blue_total, silver_total, pink_total, fox_count, bonus, sheet, person_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 		`, gameID, player.Name, player.FinalScore, player.Winner,
 			player.YellowTotal, player.GreenTotal, player.OrangeTotal,
-			player.PurpleTotal, player.BlueTotal, player.FoxCount, player.Bonus)
+			player.PurpleTotal, player.BlueTotal, player.SilverTotal, player.PinkTotal, player.FoxCount, player.Bonus, sheet, personID)
 
 		if err != nil {
 			return fmt.Errorf("failed to insert player %s: %w", player.Name, err)
 		}
 	}
 
-	// Add to high scores table (only for the winner)
-	if session.Winner != nil {
+	// Add to high scores table (only for the winners)
+	for _, winner := range session.winners() {
 		_, err := tx.Exec(`
-			INSERT INTO high_scores (game_id, player_name, score, achieved_at)
-			VALUES (?, ?, ?, ?)
-		`, gameID, session.Winner.Name, session.Winner.FinalScore, session.CompletedAt)
+			INSERT INTO high_scores (game_id, player_name, score, achieved_at, person_id)
+			VALUES (?, ?, ?, ?, ?)
+		`, gameID, winner.Name, winner.FinalScore, session.CompletedAt, personIDs[winner])
 
 		if err != nil {
 			return fmt.Errorf("failed to insert high score: %w", err)
@@ -70,10 +84,10 @@ func (d *Database) GetGameByID(gameID string) (*GameSession, error) {
 	}
 
 	err := d.DB.QueryRow(`
-		SELECT uuid, created_at, completed_at, player_count, winner_name, winner_score, notes
+		SELECT uuid, created_at, completed_at, player_count, winner_name, winner_score, notes, ruleset, solo_rating
 		FROM games WHERE uuid = ?
 	`, gameID).Scan(&gameSession.ID, &gameSession.CreatedAt, &gameSession.CompletedAt,
-		new(int), &gameSession.Winner.Name, new(int), &gameSession.Notes)
+		new(int), &gameSession.Winner.Name, new(int), &gameSession.Notes, &gameSession.Ruleset, &gameSession.SoloRating)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -90,13 +104,15 @@ func (d *Database) GetGameByID(gameID string) (*GameSession, error) {
 
 	gameSession.Players = players
 
-	// Find winner
+	// Find winners
 	for _, player := range players {
 		if player.Winner {
-			gameSession.Winner = player
-			break
+			gameSession.Winners = append(gameSession.Winners, player)
 		}
 	}
+	if len(gameSession.Winners) > 0 {
+		gameSession.Winner = gameSession.Winners[0]
+	}
 
 	return &gameSession, nil
 }
@@ -105,7 +121,7 @@ func (d *Database) GetGameByID(gameID string) (*GameSession, error) {
 func (d *Database) getPlayersByGameID(gameID string) ([]*Player, error) {
 	rows, err := d.DB.Query(`
 		SELECT id, game_id, name, final_score, winner,
-			   yellow_total, green_total, orange_total, purple_total, blue_total, fox_count, bonus
+			   yellow_total, green_total, orange_total, purple_total, blue_total, silver_total, pink_total, fox_count, bonus, sheet
 		FROM players 
 		WHERE game_id = (SELECT id FROM games WHERE uuid = ?)
 		ORDER BY final_score DESC
@@ -119,20 +135,50 @@ func (d *Database) getPlayersByGameID(gameID string) ([]*Player, error) {
 	var players []*Player
 	for rows.Next() {
 		player := &Player{}
+		var sheet string
 		err := rows.Scan(&player.ID, &player.GameID, &player.Name, &player.FinalScore, &player.Winner,
 			&player.YellowTotal, &player.GreenTotal, &player.OrangeTotal,
-			&player.PurpleTotal, &player.BlueTotal, &player.FoxCount, &player.Bonus)
+			&player.PurpleTotal, &player.BlueTotal, &player.SilverTotal, &player.PinkTotal, &player.FoxCount, &player.Bonus, &sheet)
 
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan player: %w", err)
 		}
 
+		player.Sheet, err = decodeSheet(sheet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode sheet of %s: %w", player.Name, err)
+		}
+
 		players = append(players, player)
 	}
 
 	return players, nil
 }
 
+// encodeSheet encodes a score sheet for the players table, empty when there is no sheet
+func encodeSheet(sheet *game.ScoreSheet) (string, error) {
+	if sheet == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(sheet)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// decodeSheet decodes a score sheet saved by encodeSheet, nil for players saved without one
+func decodeSheet(data string) (*game.ScoreSheet, error) {
+	if data == "" {
+		return nil, nil
+	}
+	sheet := &game.ScoreSheet{}
+	if err := json.Unmarshal([]byte(data), sheet); err != nil {
+		return nil, err
+	}
+	return sheet, nil
+}
+
 // GetGames returns a paginated list of games with optional filtering
 func (d *Database) GetGames(filter GameFilter, limit, offset int) ([]*GameSummary, int, error) {
 	whereClause := ""
@@ -147,8 +193,9 @@ func (d *Database) GetGames(filter GameFilter, limit, offset int) ([]*GameSummar
 	}
 
 	if filter.PlayerName != "" {
-		conditions = append(conditions, "EXISTS (SELECT 1 FROM players p WHERE p.game_id = games.id AND p.name LIKE ?)")
-		args = append(args, "%"+filter.PlayerName+"%")
+		// Match the person the name refers to, not every name containing it
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM players p WHERE p.game_id = games.id AND p.person_id = "+personByName+")")
+		args = append(args, NormalizeName(filter.PlayerName), NormalizeName(filter.PlayerName))
 	}
 
 	if filter.DateFrom != nil {
@@ -188,7 +235,7 @@ func (d *Database) GetGames(filter GameFilter, limit, offset int) ([]*GameSummar
 
 	// Get paginated results
 	query := fmt.Sprintf(`
-		SELECT uuid, created_at, player_count, winner_name, winner_score 
+		SELECT uuid, created_at, player_count, winner_name, winner_score, ruleset, solo_rating
 		FROM games %s %s
 		LIMIT ? OFFSET ?
 	`, whereClause, orderClause)
@@ -203,7 +250,7 @@ func (d *Database) GetGames(filter GameFilter, limit, offset int) ([]*GameSummar
 	var games []*GameSummary
 	for rows.Next() {
 		game := &GameSummary{}
-		err := rows.Scan(&game.ID, &game.CreatedAt, &game.PlayerCount, &game.WinnerName, &game.WinnerScore)
+		err := rows.Scan(&game.ID, &game.CreatedAt, &game.PlayerCount, &game.WinnerName, &game.WinnerScore, &game.Ruleset, &game.SoloRating)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan game: %w", err)
 		}
@@ -420,13 +467,15 @@ func (d *Database) DeleteGamesInDateRange(startDate, endDate time.Time) (int, er
 	return len(gameIDs), tx.Commit()
 }
 
-// GetRecentPlayerNames returns the most recently used distinct player names
+// GetRecentPlayerNames returns the names of the most recently seen people, once per person
 func (d *Database) GetRecentPlayerNames(limit int) ([]string, error) {
 	query := `
-		SELECT DISTINCT p.name 
+		SELECT pe.name
 		FROM players p 
 		JOIN games g ON p.game_id = g.id 
-		ORDER BY g.created_at DESC 
+		JOIN people pe ON pe.id = p.person_id
+		GROUP BY pe.id
+		ORDER BY MAX(g.created_at) DESC 
 		LIMIT ?
 	`
 
@@ -449,19 +498,23 @@ func (d *Database) GetRecentPlayerNames(limit int) ([]string, error) {
 	return names, nil
 }
 
-// SearchPlayerNames performs live search with pattern matching on player names
+// SearchPlayerNames performs live search on people's names and aliases and returns
+// the matching people's names, most recently seen first
 func (d *Database) SearchPlayerNames(searchTerm string, limit int) ([]string, error) {
 	query := `
-		SELECT DISTINCT p.name 
-		FROM players p 
-		JOIN games g ON p.game_id = g.id 
-		WHERE p.name LIKE ? 
-		ORDER BY g.created_at DESC 
+		SELECT pe.name
+		FROM people pe
+		LEFT JOIN players p ON p.person_id = pe.id
+		LEFT JOIN games g ON p.game_id = g.id
+		WHERE pe.name LIKE ?
+			OR EXISTS (SELECT 1 FROM person_aliases a WHERE a.person_id = pe.id AND a.alias LIKE ?)
+		GROUP BY pe.id
+		ORDER BY MAX(g.created_at) DESC, pe.name
 		LIMIT ?
 	`
 
 	pattern := "%" + searchTerm + "%"
-	rows, err := d.DB.Query(query, pattern, limit)
+	rows, err := d.DB.Query(query, pattern, pattern, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search player names: %w", err)
 	}
@@ -479,3 +532,192 @@ func (d *Database) SearchPlayerNames(searchTerm string, limit int) ([]string, er
 
 	return names, nil
 }
+
+// RecomputeBonuses re-derives the fox bonus and final score of every stored player
+// from the saved section totals, then re-resolves each game's winner and high score.
+// It returns the number of player rows whose bonus changed.
+func (d *Database) RecomputeBonuses() (int, error) {
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	updated, err := recomputeBonuses(tx)
+	if err != nil {
+		return 0, err
+	}
+	return updated, tx.Commit()
+}
+
+// recomputeBonuses is RecomputeBonuses inside a transaction, so migrations can run it
+func recomputeBonuses(tx *sql.Tx) (int, error) {
+	rows, err := tx.Query(`
+		SELECT p.id, p.game_id, g.ruleset, p.yellow_total, p.green_total, p.orange_total, p.purple_total,
+			p.blue_total, p.silver_total, p.pink_total, p.fox_count, p.bonus
+		FROM players p
+		JOIN games g ON p.game_id = g.id
+	`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query players: %w", err)
+	}
+
+	var changed []*Player
+	for rows.Next() {
+		player := &Player{}
+		var rulesetID string
+		err := rows.Scan(&player.ID, &player.GameID, &rulesetID, &player.YellowTotal, &player.GreenTotal,
+			&player.OrangeTotal, &player.PurpleTotal, &player.BlueTotal, &player.SilverTotal, &player.PinkTotal,
+			&player.FoxCount, &player.Bonus)
+		if err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan player: %w", err)
+		}
+
+		areas := game.RulesetByID(rulesetID).Areas()
+		sections := make([]int, 0, len(areas))
+		for _, area := range areas {
+			sections = append(sections, player.AreaTotal(area))
+		}
+
+		bonus := game.FoxBonus(player.FoxCount, sections)
+		if bonus != player.Bonus {
+			player.Bonus = bonus
+			player.FinalScore = bonus
+			for _, section := range sections {
+				player.FinalScore += section
+			}
+			changed = append(changed, player)
+		}
+	}
+	rows.Close()
+
+	gameIDs := make(map[int]bool)
+	for _, player := range changed {
+		_, err := tx.Exec("UPDATE players SET bonus = ?, final_score = ? WHERE id = ?",
+			player.Bonus, player.FinalScore, player.ID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to update player %d: %w", player.ID, err)
+		}
+		gameIDs[player.GameID] = true
+	}
+
+	for gameID := range gameIDs {
+		if err := resolveStoredWinner(tx, gameID); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(changed), nil
+}
+
+// resolveStoredWinner picks the winners of a stored game from its players' scores
+// and updates the game record and high scores table to match
+func resolveStoredWinner(tx *sql.Tx, gameID int) error {
+	var rulesetID string
+	var completedAt time.Time
+	err := tx.QueryRow("SELECT ruleset, completed_at FROM games WHERE id = ?", gameID).Scan(&rulesetID, &completedAt)
+	if err != nil {
+		return fmt.Errorf("failed to find game %d: %w", gameID, err)
+	}
+	ruleset := game.RulesetByID(rulesetID)
+
+	rows, err := tx.Query(`
+		SELECT id, name, final_score, person_id,
+			yellow_total, green_total, orange_total, purple_total, blue_total, silver_total, pink_total
+		FROM players
+		WHERE game_id = ?
+		ORDER BY id ASC
+	`, gameID)
+	if err != nil {
+		return fmt.Errorf("failed to query players of game %d: %w", gameID, err)
+	}
+	var players []*Player
+	var standings []game.Standing
+	personIDs := make(map[*Player]sql.NullInt64)
+	for rows.Next() {
+		player := &Player{}
+		var personID sql.NullInt64
+		err := rows.Scan(&player.ID, &player.Name, &player.FinalScore, &personID,
+			&player.YellowTotal, &player.GreenTotal, &player.OrangeTotal,
+			&player.PurpleTotal, &player.BlueTotal, &player.SilverTotal, &player.PinkTotal)
+		if err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan player: %w", err)
+		}
+		players = append(players, player)
+		personIDs[player] = personID
+		standings = append(standings, player.StandingOf(ruleset))
+	}
+	rows.Close()
+	if len(players) == 0 {
+		return fmt.Errorf("failed to find winner of game %d: no players", gameID)
+	}
+
+	var winners []*Player
+	for _, i := range game.ResolveWinners(standings) {
+		winners = append(winners, players[i])
+	}
+
+	if _, err := tx.Exec("UPDATE players SET winner = 0 WHERE game_id = ?", gameID); err != nil {
+		return fmt.Errorf("failed to update winner flags: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM high_scores WHERE game_id = ?", gameID); err != nil {
+		return fmt.Errorf("failed to update high score: %w", err)
+	}
+	for _, winner := range winners {
+		if _, err := tx.Exec("UPDATE players SET winner = 1 WHERE id = ?", winner.ID); err != nil {
+			return fmt.Errorf("failed to update winner flags: %w", err)
+		}
+		_, err := tx.Exec(`
+			INSERT INTO high_scores (game_id, player_name, score, achieved_at, person_id)
+			VALUES (?, ?, ?, ?, ?)
+		`, gameID, winner.Name, winner.FinalScore, completedAt, personIDs[winner])
+		if err != nil {
+			return fmt.Errorf("failed to update high score: %w", err)
+		}
+	}
+
+	_, err = tx.Exec("UPDATE games SET winner_name = ?, winner_score = ? WHERE id = ?", winnerNames(winners), winners[0].FinalScore, gameID)
+	if err != nil {
+		return fmt.Errorf("failed to update game winner: %w", err)
+	}
+
+	return nil
+}
+
+// rateSoloGames rates every solo game from its score, replacing ratings from an older table
+func rateSoloGames(tx *sql.Tx) (int, error) {
+	rows, err := tx.Query("SELECT id, winner_score, ruleset, solo_rating FROM games WHERE player_count = 1")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query solo games: %w", err)
+	}
+
+	type soloGame struct {
+		id, score       int
+		ruleset, rating string
+	}
+	var games []soloGame
+	for rows.Next() {
+		var g soloGame
+		if err := rows.Scan(&g.id, &g.score, &g.ruleset, &g.rating); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan solo game: %w", err)
+		}
+		games = append(games, g)
+	}
+	rows.Close()
+
+	updated := 0
+	for _, g := range games {
+		rating := game.RateSolo(game.RulesetByID(g.ruleset), g.score)
+		if rating == g.rating {
+			continue
+		}
+		if _, err := tx.Exec("UPDATE games SET solo_rating = ? WHERE id = ?", rating, g.id); err != nil {
+			return updated, fmt.Errorf("failed to rate solo game: %w", err)
+		}
+		updated++
+	}
+	return updated, nil
+}
@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+// openTestDatabase opens a migrated database in a temporary file
+func openTestDatabase(t *testing.T) *Database {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "scorer.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	database := &Database{DB: db}
+	if err := database.migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return database
+}
+
+// markedPlayer returns a player with a few boxes of every area crossed off
+func markedPlayer(t *testing.T, name string, greens ...int) *game.Player {
+	t.Helper()
+	player := game.NewPlayer(name)
+	ss := player.ScoreSheet
+	for _, box := range [][2]int{{0, 0}, {1, 0}, {2, 0}, {3, 1}} {
+		if _, err := ss.MarkYellow(box[0], box[1]); err != nil {
+			t.Fatalf("MarkYellow(%d, %d): %v", box[0], box[1], err)
+		}
+	}
+	for _, value := range greens {
+		if _, err := ss.MarkGreen(value); err != nil {
+			t.Fatalf("MarkGreen(%d): %v", value, err)
+		}
+	}
+	for _, value := range []int{4, 5, 6} {
+		if _, err := ss.MarkOrange(value); err != nil {
+			t.Fatalf("MarkOrange(%d): %v", value, err)
+		}
+		if _, err := ss.MarkPurple(value); err != nil {
+			t.Fatalf("MarkPurple(%d): %v", value, err)
+		}
+	}
+	for _, sum := range []int{2, 3, 7} {
+		if _, err := ss.MarkBlue(sum); err != nil {
+			t.Fatalf("MarkBlue(%d): %v", sum, err)
+		}
+	}
+	return player
+}
+
+func TestSaveGameRoundTripsSheets(t *testing.T) {
+	db := openTestDatabase(t)
+	players := []*game.Player{
+		markedPlayer(t, "Alice", 1, 2, 3, 4),
+		markedPlayer(t, "Bob", 1, 2),
+	}
+	session := NewGameSession(players, "round trip")
+	if err := db.SaveGame(session); err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+
+	saved, err := db.GetGameByID(session.ID)
+	if err != nil {
+		t.Fatalf("GetGameByID: %v", err)
+	}
+	if len(saved.Players) != len(players) {
+		t.Fatalf("got %d players, want %d", len(saved.Players), len(players))
+	}
+
+	for _, want := range players {
+		var got *Player
+		for _, player := range saved.Players {
+			if player.Name == want.Name {
+				got = player
+			}
+		}
+		if got == nil {
+			t.Fatalf("player %s was not saved", want.Name)
+		}
+		if got.Sheet == nil {
+			t.Fatalf("sheet of %s was not saved", want.Name)
+		}
+
+		wantJSON, err := json.Marshal(want.ScoreSheet)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		gotJSON, err := json.Marshal(got.Sheet)
+		if err != nil {
+			t.Fatalf("Marshal: %v", err)
+		}
+		if string(gotJSON) != string(wantJSON) {
+			t.Errorf("sheet of %s changed in storage\ngot:  %s\nwant: %s", want.Name, gotJSON, wantJSON)
+		}
+		if got.Sheet.GetTotalScore() != want.GetTotalScore() || got.FinalScore != want.GetTotalScore() {
+			t.Errorf("%s total = %d (final score %d), want %d", want.Name, got.Sheet.GetTotalScore(), got.FinalScore, want.GetTotalScore())
+		}
+		if !got.Sheet.Yellow.IsMarked(0, 0) {
+			t.Errorf("yellow mark of %s was lost", want.Name)
+		}
+	}
+}
+
+func TestLegacyPlayerHasNoSheet(t *testing.T) {
+	db := openTestDatabase(t)
+	session := NewGameSession([]*game.Player{markedPlayer(t, "Alice", 1)}, "")
+	if err := db.SaveGame(session); err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+	// Players saved before sheets were stored have an empty sheet column
+	if _, err := db.DB.Exec("UPDATE players SET sheet = ''"); err != nil {
+		t.Fatalf("failed to clear sheets: %v", err)
+	}
+
+	saved, err := db.GetGameByID(session.ID)
+	if err != nil {
+		t.Fatalf("GetGameByID: %v", err)
+	}
+	if sheet := saved.Players[0].Sheet; sheet != nil {
+		t.Errorf("legacy player sheet = %+v, want nil", sheet)
+	}
+	if saved.Players[0].FinalScore != session.Players[0].FinalScore {
+		t.Errorf("final score = %d, want %d", saved.Players[0].FinalScore, session.Players[0].FinalScore)
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"fmt"
 )
 
-// GetHighScores returns the top high scores
+// GetHighScores returns the top high scores of multiplayer games; solo games are listed
+// by GetSoloHighScores instead
 func (d *Database) GetHighScores(limit int) ([]*HighScore, error) {
 	query := `
-		SELECT hs.id, hs.game_id, hs.player_name, hs.score, hs.achieved_at
+		SELECT hs.id, hs.game_id, COALESCE(pe.name, hs.player_name), hs.score, hs.achieved_at
 		FROM high_scores hs
+		JOIN games g ON g.id = hs.game_id
+		LEFT JOIN people pe ON pe.id = hs.person_id
+		WHERE g.player_count > 1
 		ORDER BY hs.score DESC, hs.achieved_at ASC
 		LIMIT ?
 	`
@@ -32,17 +36,50 @@ func (d *Database) GetHighScores(limit int) ([]*HighScore, error) {
 	return highScores, nil
 }
 
-// GetPlayerHighScores returns high scores for a specific player
+// GetSoloHighScores returns the top scores of solo games with their rating
+func (d *Database) GetSoloHighScores(limit int) ([]*HighScore, error) {
+	query := `
+		SELECT hs.id, hs.game_id, COALESCE(pe.name, hs.player_name), hs.score, hs.achieved_at, g.solo_rating
+		FROM high_scores hs
+		JOIN games g ON g.id = hs.game_id
+		LEFT JOIN people pe ON pe.id = hs.person_id
+		WHERE g.player_count = 1
+		ORDER BY hs.score DESC, hs.achieved_at ASC
+		LIMIT ?
+	`
+
+	rows, err := d.DB.Query(query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query solo high scores: %w", err)
+	}
+	defer rows.Close()
+
+	var highScores []*HighScore
+	for rows.Next() {
+		hs := &HighScore{}
+		err := rows.Scan(&hs.ID, &hs.GameID, &hs.PlayerName, &hs.Score, &hs.AchievedAt, &hs.SoloRating)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan solo high score: %w", err)
+		}
+		highScores = append(highScores, hs)
+	}
+
+	return highScores, nil
+}
+
+// GetPlayerHighScores returns high scores for the person a name or alias refers to
 func (d *Database) GetPlayerHighScores(playerName string, limit int) ([]*HighScore, error) {
 	query := `
-		SELECT hs.id, hs.game_id, hs.player_name, hs.score, hs.achieved_at
+		SELECT hs.id, hs.game_id, COALESCE(pe.name, hs.player_name), hs.score, hs.achieved_at
 		FROM high_scores hs
-		WHERE hs.player_name LIKE ?
+		LEFT JOIN people pe ON pe.id = hs.person_id
+		WHERE hs.person_id = ` + personByName + `
 		ORDER BY hs.score DESC, hs.achieved_at ASC
 		LIMIT ?
 	`
 
-	rows, err := d.DB.Query(query, playerName, limit)
+	name := NormalizeName(playerName)
+	rows, err := d.DB.Query(query, name, name, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query player high scores: %w", err)
 	}
@@ -71,14 +108,17 @@ func (d *Database) GetBestSectionScores(limit int) (map[string][]*Player, error)
 		"orange": "orange_total",
 		"purple": "purple_total",
 		"blue":   "blue_total",
+		"silver": "silver_total",
+		"pink":   "pink_total",
 		"bonus":  "bonus",
 	}
 
 	for sectionName, columnName := range sections {
 		query := fmt.Sprintf(`
-			SELECT name, %s, achieved_at
+			SELECT COALESCE(pe.name, p.name), %s, achieved_at
 			FROM players p
 			JOIN games g ON p.game_id = g.id
+			LEFT JOIN people pe ON pe.id = p.person_id
 			WHERE %s > 0
 			ORDER BY %s DESC, g.created_at ASC
 			LIMIT ?
@@ -121,6 +161,10 @@ func getSectionScorePointer(player *Player, section string) interface{} {
 		return &player.PurpleTotal
 	case "blue":
 		return &player.BlueTotal
+	case "silver":
+		return &player.SilverTotal
+	case "pink":
+		return &player.PinkTotal
 	case "bonus":
 		return &player.Bonus
 	default:
@@ -155,17 +199,18 @@ func (d *Database) IsHighScore(score int, limit int) (bool, int, error) {
 	return score >= *lowestScore, *lowestScore, nil
 }
 
-// GetPlayerStatistics returns detailed statistics for a player
+// GetPlayerStatistics returns detailed statistics for the person a name or alias refers to
 func (d *Database) GetPlayerStatistics(playerName string) (map[string]interface{}, error) {
 	stats := make(map[string]interface{})
+	name := NormalizeName(playerName)
 
 	// Total games played
 	var totalGames int
 	err := d.DB.QueryRow(`
 		SELECT COUNT(*)
 		FROM players p
-		WHERE p.name LIKE ?
-	`, playerName).Scan(&totalGames)
+		WHERE p.person_id = `+personByName+`
+	`, name, name).Scan(&totalGames)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get total games: %w", err)
 	}
@@ -176,8 +221,8 @@ func (d *Database) GetPlayerStatistics(playerName string) (map[string]interface{
 	err = d.DB.QueryRow(`
 		SELECT COUNT(*)
 		FROM players p
-		WHERE p.name LIKE ? AND p.winner = TRUE
-	`, playerName).Scan(&gamesWon)
+		WHERE p.person_id = `+personByName+` AND p.winner = TRUE
+	`, name, name).Scan(&gamesWon)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get games won: %w", err)
 	}
@@ -195,8 +240,8 @@ func (d *Database) GetPlayerStatistics(playerName string) (map[string]interface{
 	err = d.DB.QueryRow(`
 		SELECT MAX(final_score)
 		FROM players p
-		WHERE p.name LIKE ?
-	`, playerName).Scan(&bestScore)
+		WHERE p.person_id = `+personByName+`
+	`, name, name).Scan(&bestScore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get best score: %w", err)
 	}
@@ -207,8 +252,8 @@ func (d *Database) GetPlayerStatistics(playerName string) (map[string]interface{
 	err = d.DB.QueryRow(`
 		SELECT AVG(final_score)
 		FROM players p
-		WHERE p.name LIKE ?
-	`, playerName).Scan(&avgScore)
+		WHERE p.person_id = `+personByName+`
+	`, name, name).Scan(&avgScore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get average score: %w", err)
 	}
@@ -221,6 +266,8 @@ func (d *Database) GetPlayerStatistics(playerName string) (map[string]interface{
 		"best_orange": "orange_total",
 		"best_purple": "purple_total",
 		"best_blue":   "blue_total",
+		"best_silver": "silver_total",
+		"best_pink":   "pink_total",
 		"best_bonus":  "bonus",
 	}
 
@@ -229,8 +276,8 @@ func (d *Database) GetPlayerStatistics(playerName string) (map[string]interface{
 		err = d.DB.QueryRow(fmt.Sprintf(`
 			SELECT MAX(%s)
 			FROM players p
-			WHERE p.name LIKE ?
-		`, columnName), playerName).Scan(&best)
+			WHERE p.person_id = %s
+		`, columnName, personByName), name, name).Scan(&best)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get best %s: %w", columnName, err)
 		}
@@ -0,0 +1,292 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// ErrDatabaseTooNew is returned when the database was written by a newer version of the app
+var ErrDatabaseTooNew = errors.New("database was created by a newer version of the app")
+
+// migration moves the schema up by one version
+type migration struct {
+	version     int
+	description string
+	up          func(tx *sql.Tx) error
+}
+
+// migrations are applied in order; append new ones at the end and never edit a released one.
+// The schema version is stored in PRAGMA user_version and equals the last applied migration.
+var migrations = []migration{
+	{1, "create games, players and high scores", createInitialSchema},
+	{2, "add editions with silver and pink areas", func(tx *sql.Tx) error {
+		return addColumns(tx, []column{
+			{"games", "ruleset", "TEXT NOT NULL DEFAULT 'ganz-schoen-clever'"},
+			{"players", "silver_total", "INTEGER DEFAULT 0"},
+			{"players", "pink_total", "INTEGER DEFAULT 0"},
+		})
+	}},
+	{3, "add solo ratings", func(tx *sql.Tx) error {
+		return addColumns(tx, []column{
+			{"games", "solo_rating", "TEXT NOT NULL DEFAULT ''"},
+		})
+	}},
+	{4, "add saved score sheets", func(tx *sql.Tx) error {
+		return addColumns(tx, []column{
+			{"players", "sheet", "TEXT NOT NULL DEFAULT ''"},
+		})
+	}},
+	{5, "add the draft of the game in progress", func(tx *sql.Tx) error {
+		_, err := tx.Exec(`
+		CREATE TABLE IF NOT EXISTS drafts (
+			id INTEGER PRIMARY KEY CHECK (id = 1),
+			state TEXT NOT NULL,
+			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		);`)
+		return err
+	}},
+	{6, "add people with aliases", migratePeople},
+	{7, "recompute fox bonuses of games saved before the fox bonus fix", func(tx *sql.Tx) error {
+		// Games saved before the fix skipped empty areas; this used to be gated by an app preference
+		updated, err := recomputeBonuses(tx)
+		if err != nil {
+			return err
+		}
+		log.Printf("Recomputed fox bonus for %d players", updated)
+		return nil
+	}},
+	{8, "rate solo games with the rulebook's solo table", func(tx *sql.Tx) error {
+		// Solo games saved before ratings existed have none, and earlier ratings used other tiers
+		updated, err := rateSoloGames(tx)
+		if err != nil {
+			return err
+		}
+		log.Printf("Rated %d solo games", updated)
+		return nil
+	}},
+}
+
+// SchemaVersion returns the schema version this build of the app expects
+func SchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// migrate applies every migration newer than the database's schema version in one transaction,
+// so a failed upgrade leaves the database as it was
+func (d *Database) migrate() error {
+	var current int
+	if err := d.DB.QueryRow("PRAGMA user_version").Scan(&current); err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if current > SchemaVersion() {
+		return fmt.Errorf("%w: schema version %d, this app supports up to %d", ErrDatabaseTooNew, current, SchemaVersion())
+	}
+	if current == SchemaVersion() {
+		return nil
+	}
+
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin migration: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if err := m.up(tx); err != nil {
+			return fmt.Errorf("failed to apply migration %d (%s): %w", m.version, m.description, err)
+		}
+	}
+
+	// PRAGMA does not take bound parameters; the version is one of our own constants
+	if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", SchemaVersion())); err != nil {
+		return fmt.Errorf("failed to set schema version: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration: %w", err)
+	}
+
+	log.Printf("Migrated database schema from version %d to %d", current, SchemaVersion())
+	return nil
+}
+
+// createInitialSchema creates the tables of the first release. Databases from before
+// migrations existed already have them, so every statement is safe to run again.
+func createInitialSchema(tx *sql.Tx) error {
+	gamesTable := `
+	CREATE TABLE IF NOT EXISTS games (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		uuid TEXT UNIQUE NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		completed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		player_count INTEGER NOT NULL,
+		winner_name TEXT,
+		winner_score INTEGER,
+		notes TEXT
+	);`
+
+	playersTable := `
+	CREATE TABLE IF NOT EXISTS players (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		game_id INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		final_score INTEGER NOT NULL,
+		winner BOOLEAN DEFAULT FALSE,
+
+		-- Section totals only
+		yellow_total INTEGER DEFAULT 0,
+		green_total INTEGER DEFAULT 0,
+		orange_total INTEGER DEFAULT 0,
+		purple_total INTEGER DEFAULT 0,
+		blue_total INTEGER DEFAULT 0,
+		fox_count INTEGER DEFAULT 0,
+		bonus INTEGER DEFAULT 0,
+
+		FOREIGN KEY (game_id) REFERENCES games(id)
+	);`
+
+	highScoresTable := `
+	CREATE TABLE IF NOT EXISTS high_scores (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		game_id INTEGER NOT NULL,
+		player_name TEXT NOT NULL,
+		score INTEGER NOT NULL,
+		achieved_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (game_id) REFERENCES games(id)
+	);`
+
+	indexes := []string{
+		"CREATE INDEX IF NOT EXISTS idx_games_date ON games(created_at DESC);",
+		"CREATE INDEX IF NOT EXISTS idx_games_score ON games(winner_score DESC);",
+		"CREATE INDEX IF NOT EXISTS idx_players_game ON players(game_id);",
+		"CREATE INDEX IF NOT EXISTS idx_high_scores ON high_scores(score DESC);",
+		"CREATE INDEX IF NOT EXISTS idx_players_name ON players(name);",
+	}
+
+	for _, table := range []string{gamesTable, playersTable, highScoresTable} {
+		if _, err := tx.Exec(table); err != nil {
+			return fmt.Errorf("failed to create table: %w", err)
+		}
+	}
+	for _, index := range indexes {
+		if _, err := tx.Exec(index); err != nil {
+			return fmt.Errorf("failed to create index: %w", err)
+		}
+	}
+	return nil
+}
+
+// migratePeople creates the people and alias tables and links every saved player and
+// high score to a person. Names that differ only in case or spacing become the same person.
+func migratePeople(tx *sql.Tx) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS people (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		);`,
+		`CREATE TABLE IF NOT EXISTS person_aliases (
+			alias TEXT PRIMARY KEY COLLATE NOCASE,
+			person_id INTEGER NOT NULL,
+			FOREIGN KEY (person_id) REFERENCES people(id)
+		);`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement); err != nil {
+			return fmt.Errorf("failed to create table: %w", err)
+		}
+	}
+
+	err := addColumns(tx, []column{
+		{"players", "person_id", "INTEGER REFERENCES people(id)"},
+		{"high_scores", "person_id", "INTEGER REFERENCES people(id)"},
+	})
+	if err != nil {
+		return err
+	}
+	indexes := []string{
+		"CREATE INDEX IF NOT EXISTS idx_players_person ON players(person_id);",
+		"CREATE INDEX IF NOT EXISTS idx_high_scores_person ON high_scores(person_id);",
+	}
+	for _, index := range indexes {
+		if _, err := tx.Exec(index); err != nil {
+			return fmt.Errorf("failed to create index: %w", err)
+		}
+	}
+
+	rows, err := tx.Query("SELECT name FROM players UNION SELECT player_name FROM high_scores")
+	if err != nil {
+		return fmt.Errorf("failed to query player names: %w", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan player name: %w", err)
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+
+	for _, name := range names {
+		personID, err := resolvePerson(tx, name)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec("UPDATE players SET person_id = ? WHERE name = ?", personID, name); err != nil {
+			return fmt.Errorf("failed to link players to %q: %w", name, err)
+		}
+		if _, err := tx.Exec("UPDATE high_scores SET person_id = ? WHERE player_name = ?", personID, name); err != nil {
+			return fmt.Errorf("failed to link high scores to %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// column is a column added to a table after it was first created
+type column struct{ table, name, definition string }
+
+// addColumns adds columns to their tables. Databases from before migrations existed may
+// already have some of them, so a column that is already there is left alone.
+func addColumns(tx *sql.Tx, columns []column) error {
+	for _, c := range columns {
+		exists, err := columnExists(tx, c.table, c.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", c.table, c.name, c.definition)); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", c.table, c.name, err)
+		}
+	}
+	return nil
+}
+
+// columnExists reports whether a table has a column
+func columnExists(tx *sql.Tx, table, column string) (bool, error) {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, fmt.Errorf("failed to read columns of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, colType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+			return false, fmt.Errorf("failed to scan column of %s: %w", table, err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
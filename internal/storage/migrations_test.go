@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// baselineSchema is the schema of the first release, created before migrations existed
+var baselineSchema = []string{
+	`CREATE TABLE IF NOT EXISTS games (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		uuid TEXT UNIQUE NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		completed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		player_count INTEGER NOT NULL,
+		winner_name TEXT,
+		winner_score INTEGER,
+		notes TEXT
+	);`,
+	`CREATE TABLE IF NOT EXISTS players (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		game_id INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		final_score INTEGER NOT NULL,
+		winner BOOLEAN DEFAULT FALSE,
+		yellow_total INTEGER DEFAULT 0,
+		green_total INTEGER DEFAULT 0,
+		orange_total INTEGER DEFAULT 0,
+		purple_total INTEGER DEFAULT 0,
+		blue_total INTEGER DEFAULT 0,
+		fox_count INTEGER DEFAULT 0,
+		bonus INTEGER DEFAULT 0,
+		FOREIGN KEY (game_id) REFERENCES games(id)
+	);`,
+	`CREATE TABLE IF NOT EXISTS high_scores (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		game_id INTEGER NOT NULL,
+		player_name TEXT NOT NULL,
+		score INTEGER NOT NULL,
+		achieved_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (game_id) REFERENCES games(id)
+	);`,
+	"CREATE INDEX IF NOT EXISTS idx_games_date ON games(created_at DESC);",
+	"CREATE INDEX IF NOT EXISTS idx_players_name ON players(name);",
+}
+
+// openBaselineDatabase opens a temporary database with the first release's schema and a
+// saved game, at schema version 0. Bob's bonus is from before the fox bonus fix, which
+// skipped his empty green area.
+func openBaselineDatabase(t *testing.T) *Database {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "baseline.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	statements := append(baselineSchema,
+		`INSERT INTO games (id, uuid, player_count, winner_name, winner_score, notes)
+			VALUES (1, 'baseline-game', 2, 'Sam', 120, 'from the first release')`,
+		`INSERT INTO players (game_id, name, final_score, winner, yellow_total, green_total, orange_total, purple_total, blue_total, fox_count, bonus)
+			VALUES (1, 'Sam', 120, TRUE, 20, 20, 20, 20, 20, 1, 20)`,
+		`INSERT INTO players (game_id, name, final_score, winner, yellow_total, green_total, orange_total, purple_total, blue_total, fox_count, bonus)
+			VALUES (1, 'Bob', 60, FALSE, 10, 0, 10, 10, 20, 1, 10)`,
+		`INSERT INTO high_scores (game_id, player_name, score) VALUES (1, 'Sam', 120)`,
+	)
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("failed to build baseline database: %v", err)
+		}
+	}
+	return &Database{DB: db}
+}
+
+func schemaVersion(t *testing.T, db *Database) int {
+	t.Helper()
+	var version int
+	if err := db.DB.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	return version
+}
+
+func TestMigrateBaselineDatabase(t *testing.T) {
+	db := openBaselineDatabase(t)
+	if err := db.migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if got := schemaVersion(t, db); got != SchemaVersion() {
+		t.Fatalf("schema version = %d, want %d", got, SchemaVersion())
+	}
+
+	session, err := db.GetGameByID("baseline-game")
+	if err != nil {
+		t.Fatalf("GetGameByID: %v", err)
+	}
+	if session.Notes != "from the first release" || session.Ruleset != "ganz-schoen-clever" || session.SoloRating != "" {
+		t.Errorf("game = notes %q, ruleset %q, solo rating %q", session.Notes, session.Ruleset, session.SoloRating)
+	}
+	if len(session.Players) != 2 {
+		t.Fatalf("got %d players, want 2", len(session.Players))
+	}
+	for _, player := range session.Players {
+		if player.Sheet != nil {
+			t.Errorf("%s has a sheet, want nil for a baseline player", player.Name)
+		}
+		// The fox bonus counts empty areas since the fix, so Bob's 0 green leaves him no bonus
+		if player.Name == "Bob" && (player.Bonus != 0 || player.FinalScore != 50) {
+			t.Errorf("Bob bonus %d, final score %d, want 0 and 50 after the recompute", player.Bonus, player.FinalScore)
+		}
+		if player.Name == "Sam" && (player.Bonus != 20 || player.FinalScore != 120 || !player.Winner) {
+			t.Errorf("Sam bonus %d, final score %d, winner %v, want 20, 120 and the win", player.Bonus, player.FinalScore, player.Winner)
+		}
+	}
+
+	people, err := db.GetPeople()
+	if err != nil {
+		t.Fatalf("GetPeople: %v", err)
+	}
+	if len(people) != 2 {
+		t.Fatalf("got %d people, want Bob and Sam", len(people))
+	}
+	highScores, err := db.GetPlayerHighScores("sam", 10)
+	if err != nil {
+		t.Fatalf("GetPlayerHighScores: %v", err)
+	}
+	if len(highScores) != 1 || highScores[0].Score != 120 {
+		t.Errorf("Sam's high scores = %+v, want the 120 from the baseline game", highScores)
+	}
+}
+
+func TestMigrateAtCurrentVersion(t *testing.T) {
+	db := openBaselineDatabase(t)
+	if err := db.migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	people, err := db.GetPeople()
+	if err != nil {
+		t.Fatalf("GetPeople: %v", err)
+	}
+
+	// Running again, as every launch does, changes nothing
+	if err := db.migrate(); err != nil {
+		t.Fatalf("second migrate: %v", err)
+	}
+	if got := schemaVersion(t, db); got != SchemaVersion() {
+		t.Errorf("schema version = %d, want %d", got, SchemaVersion())
+	}
+	again, err := db.GetPeople()
+	if err != nil {
+		t.Fatalf("GetPeople: %v", err)
+	}
+	if len(again) != len(people) {
+		t.Errorf("got %d people after migrating again, want %d", len(again), len(people))
+	}
+	var players int
+	if err := db.DB.QueryRow("SELECT COUNT(*) FROM players").Scan(&players); err != nil {
+		t.Fatalf("failed to count players: %v", err)
+	}
+	if players != 2 {
+		t.Errorf("got %d players after migrating again, want 2", players)
+	}
+}
+
+func TestMigrateRefusesNewerDatabase(t *testing.T) {
+	db := openBaselineDatabase(t)
+	newer := SchemaVersion() + 1
+	if _, err := db.DB.Exec(fmt.Sprintf("PRAGMA user_version = %d", newer)); err != nil {
+		t.Fatalf("failed to set schema version: %v", err)
+	}
+
+	err := db.migrate()
+	if !errors.Is(err, ErrDatabaseTooNew) {
+		t.Fatalf("migrate error = %v, want ErrDatabaseTooNew", err)
+	}
+	if got := schemaVersion(t, db); got != newer {
+		t.Errorf("schema version = %d, want %d left alone", got, newer)
+	}
+	exists, err := tableExists(db, "people")
+	if err != nil {
+		t.Fatalf("tableExists: %v", err)
+	}
+	if exists {
+		t.Error("newer database was modified: people table was created")
+	}
+}
+
+func tableExists(db *Database, table string) (bool, error) {
+	var count int
+	err := db.DB.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count)
+	return count > 0, err
+}
+
+func TestMigrateRatesSoloGames(t *testing.T) {
+	db := openBaselineDatabase(t)
+	if _, err := db.DB.Exec(`
+		INSERT INTO games (id, uuid, player_count, winner_name, winner_score, notes) VALUES (2, 'solo-game', 1, 'Sam', 230, '')
+	`); err != nil {
+		t.Fatalf("failed to add solo game: %v", err)
+	}
+	if err := db.migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	session, err := db.GetGameByID("solo-game")
+	if err != nil {
+		t.Fatalf("GetGameByID: %v", err)
+	}
+	if session.SoloRating != "Pretty clever" {
+		t.Errorf("unrated solo game rating = %q, want \"Pretty clever\"", session.SoloRating)
+	}
+
+	// Ratings stored from an older table are replaced once
+	if _, err := db.DB.Exec("UPDATE games SET solo_rating = 'Brilliant' WHERE uuid = 'solo-game'"); err != nil {
+		t.Fatalf("failed to set old rating: %v", err)
+	}
+	if _, err := db.DB.Exec(fmt.Sprintf("PRAGMA user_version = %d", SchemaVersion()-1)); err != nil {
+		t.Fatalf("failed to set schema version: %v", err)
+	}
+	if err := db.migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	session, err = db.GetGameByID("solo-game")
+	if err != nil {
+		t.Fatalf("GetGameByID: %v", err)
+	}
+	if session.SoloRating != "Pretty clever" {
+		t.Errorf("re-rated solo game rating = %q, want \"Pretty clever\"", session.SoloRating)
+	}
+}
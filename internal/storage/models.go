@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,8 +15,11 @@ type GameSession struct {
 	CreatedAt   time.Time `json:"created_at"`
 	CompletedAt time.Time `json:"completed_at"`
 	Players     []*Player `json:"players"`
-	Winner      *Player   `json:"winner,omitempty"`
+	Winner      *Player   `json:"winner,omitempty"`  // first winner, kept for single-winner callers
+	Winners     []*Player `json:"winners,omitempty"` // every winner, more than one on a shared win
 	Notes       string    `json:"notes"`
+	Ruleset     string    `json:"ruleset"`
+	SoloRating  string    `json:"solo_rating,omitempty"` // rating of a solo game, empty otherwise
 }
 
 // Player represents a player in a saved game
@@ -29,8 +34,35 @@ type Player struct {
 	OrangeTotal int    `json:"orange_total"`
 	PurpleTotal int    `json:"purple_total"`
 	BlueTotal   int    `json:"blue_total"`
+	SilverTotal int    `json:"silver_total"`
+	PinkTotal   int    `json:"pink_total"`
 	FoxCount    int    `json:"fox_count"`
 	Bonus       int    `json:"bonus"`
+
+	// Sheet holds the marks as played, nil for games saved before sheets were stored
+	Sheet *game.ScoreSheet `json:"sheet,omitempty"`
+}
+
+// AreaTotal returns the saved total for an area
+func (p *Player) AreaTotal(area game.Color) int {
+	switch area {
+	case game.ColorYellow:
+		return p.YellowTotal
+	case game.ColorGreen:
+		return p.GreenTotal
+	case game.ColorOrange:
+		return p.OrangeTotal
+	case game.ColorPurple:
+		return p.PurpleTotal
+	case game.ColorBlue:
+		return p.BlueTotal
+	case game.ColorSilver:
+		return p.SilverTotal
+	case game.ColorPink:
+		return p.PinkTotal
+	default:
+		return 0
+	}
 }
 
 // HighScore represents a high score entry
@@ -40,6 +72,7 @@ type HighScore struct {
 	PlayerName string    `json:"player_name"`
 	Score      int       `json:"score"`
 	AchievedAt time.Time `json:"achieved_at"`
+	SoloRating string    `json:"solo_rating,omitempty"`
 }
 
 // GameSummary represents a game for list views
@@ -49,6 +82,8 @@ type GameSummary struct {
 	PlayerCount int       `json:"player_count"`
 	WinnerName  string    `json:"winner_name"`
 	WinnerScore int       `json:"winner_score"`
+	Ruleset     string    `json:"ruleset"`
+	SoloRating  string    `json:"solo_rating,omitempty"`
 }
 
 // SortBy defines sorting options for game queries
@@ -80,60 +115,107 @@ type GameFilter struct {
 
 // ToPlayer converts a game.Player to storage.Player
 func ToPlayer(gamePlayer *game.Player, gameID int) *Player {
+	sheet := gamePlayer.ScoreSheet
 	return &Player{
 		GameID:      gameID,
 		Name:        gamePlayer.Name,
 		FinalScore:  gamePlayer.GetTotalScore(),
-		YellowTotal: gamePlayer.ScoreSheet.Yellow.Total,
-		GreenTotal:  gamePlayer.ScoreSheet.Green.Total,
-		OrangeTotal: gamePlayer.ScoreSheet.Orange.Total,
-		PurpleTotal: gamePlayer.ScoreSheet.Purple.Total,
-		BlueTotal:   gamePlayer.ScoreSheet.Blue.Total,
-		FoxCount:    gamePlayer.ScoreSheet.Bonus.FoxCount,
-		Bonus:       gamePlayer.ScoreSheet.Bonus.Total,
+		YellowTotal: sheet.AreaTotal(game.ColorYellow),
+		GreenTotal:  sheet.AreaTotal(game.ColorGreen),
+		OrangeTotal: sheet.AreaTotal(game.ColorOrange),
+		PurpleTotal: sheet.AreaTotal(game.ColorPurple),
+		BlueTotal:   sheet.AreaTotal(game.ColorBlue),
+		SilverTotal: sheet.AreaTotal(game.ColorSilver),
+		PinkTotal:   sheet.AreaTotal(game.ColorPink),
+		FoxCount:    sheet.Bonus.FoxCount,
+		Bonus:       sheet.Bonus.Total,
+		Sheet:       sheet.Clone(),
 	}
 }
 
 // NewGameSession creates a new GameSession from game.Players
 func NewGameSession(players []*game.Player, notes string) *GameSession {
-	var winner *game.Player
-	highestScore := -1
-
-	// Find the winner
-	for _, player := range players {
-		score := player.GetTotalScore()
-		if score > highestScore {
-			highestScore = score
-			winner = player
-		}
-	}
+	winners := game.Winners(players)
 
 	// Create player models
 	storagePlayers := make([]*Player, 0, len(players))
+	var storageWinners []*Player
 	for _, player := range players {
 		storagePlayer := ToPlayer(player, 0) // GameID will be set after saving
-		storagePlayer.Winner = (player == winner)
+		storagePlayer.Winner = slices.Contains(winners, player)
 		storagePlayers = append(storagePlayers, storagePlayer)
+		if storagePlayer.Winner {
+			storageWinners = append(storageWinners, storagePlayer)
+		}
+	}
+
+	ruleset := game.DefaultRuleset()
+	if len(players) > 0 {
+		ruleset = players[0].ScoreSheet.Ruleset
+	}
+
+	soloRating := ""
+	if game.IsSolo(players) {
+		soloRating = game.RateSolo(ruleset, players[0].GetTotalScore())
 	}
 
-	return &GameSession{
+	session := &GameSession{
 		ID:          uuid.New().String(),
 		CreatedAt:   time.Now(),
 		CompletedAt: time.Now(),
 		Players:     storagePlayers,
-		Winner:      ToPlayer(winner, 0),
+		Winners:     storageWinners,
 		Notes:       notes,
+		Ruleset:     ruleset.ID(),
+		SoloRating:  soloRating,
 	}
+	if len(storageWinners) > 0 {
+		session.Winner = storageWinners[0]
+	}
+	return session
+}
+
+// StandingOf returns the standing of a saved player for winner resolution
+func (p *Player) StandingOf(ruleset game.Ruleset) game.Standing {
+	areas := ruleset.Areas()
+	standing := game.Standing{Total: p.FinalScore, Areas: make([]int, 0, len(areas))}
+	for _, area := range areas {
+		standing.Areas = append(standing.Areas, p.AreaTotal(area))
+	}
+	return standing
+}
+
+// winnerNames joins the names of the winners, like "Alice & Bob" on a shared win
+func winnerNames(winners []*Player) string {
+	names := make([]string, 0, len(winners))
+	for _, winner := range winners {
+		names = append(names, winner.Name)
+	}
+	return strings.Join(names, " & ")
 }
 
-// GetWinnerName returns the winner's name
+// GetWinnerName returns the winner's name, or every winner's name on a shared win
 func (gs *GameSession) GetWinnerName() string {
+	if len(gs.Winners) > 0 {
+		return winnerNames(gs.Winners)
+	}
 	if gs.Winner != nil {
 		return gs.Winner.Name
 	}
 	return ""
 }
 
+// winners returns every winner, falling back to the single Winner of older callers
+func (gs *GameSession) winners() []*Player {
+	if len(gs.Winners) > 0 {
+		return gs.Winners
+	}
+	if gs.Winner != nil {
+		return []*Player{gs.Winner}
+	}
+	return nil
+}
+
 // GetWinnerScore returns the winner's score
 func (gs *GameSession) GetWinnerScore() int {
 	if gs.Winner != nil {
@@ -0,0 +1,443 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrPersonNotFound is returned when no person has the given ID, name or alias
+	ErrPersonNotFound = errors.New("player not found")
+	// ErrNameTaken is returned when a name or alias already belongs to someone else
+	ErrNameTaken = errors.New("name is already used by another player")
+)
+
+// Person is a player identity shared by all of their saved games
+type Person struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Aliases   []string  `json:"aliases,omitempty"` // other names that refer to this person
+	Games     int       `json:"games"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// personByName is a subquery for the ID of the person a name or alias refers to.
+// It takes the normalized name twice, once for each table.
+const personByName = `(SELECT id FROM people WHERE name = ?
+	UNION ALL SELECT person_id FROM person_aliases WHERE alias = ? LIMIT 1)`
+
+// rowQuerier is implemented by both *sql.DB and *sql.Tx
+type rowQuerier interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// NormalizeName trims a name and collapses runs of whitespace, so "  Sam  Smith " is "Sam Smith"
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// lookupPerson returns the ID of the person with the given name or alias.
+// Names and aliases are compared without regard to case.
+func lookupPerson(q rowQuerier, name string) (int, error) {
+	name = NormalizeName(name)
+	var id int
+	err := q.QueryRow("SELECT id FROM people WHERE name = ?", name).Scan(&id)
+	if err == sql.ErrNoRows {
+		err = q.QueryRow("SELECT person_id FROM person_aliases WHERE alias = ?", name).Scan(&id)
+	}
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("%w: %q", ErrPersonNotFound, name)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to look up player %q: %w", name, err)
+	}
+	return id, nil
+}
+
+// resolvePerson returns the person a name refers to, adding them on first use.
+// A blank name belongs to nobody and resolves to a NULL person.
+func resolvePerson(tx *sql.Tx, name string) (sql.NullInt64, error) {
+	name = NormalizeName(name)
+	if name == "" {
+		return sql.NullInt64{}, nil
+	}
+
+	id, err := lookupPerson(tx, name)
+	if err == nil {
+		return sql.NullInt64{Int64: int64(id), Valid: true}, nil
+	}
+	if !errors.Is(err, ErrPersonNotFound) {
+		return sql.NullInt64{}, err
+	}
+
+	result, err := tx.Exec("INSERT INTO people (name, created_at) VALUES (?, ?)", name, time.Now())
+	if err != nil {
+		return sql.NullInt64{}, fmt.Errorf("failed to add player %q: %w", name, err)
+	}
+	newID, err := result.LastInsertId()
+	if err != nil {
+		return sql.NullInt64{}, fmt.Errorf("failed to get player ID: %w", err)
+	}
+	return sql.NullInt64{Int64: newID, Valid: true}, nil
+}
+
+// GetPeople returns every person with saved games, with their aliases and number of games,
+// by name. People whose games were all deleted are left out but keep their aliases, so they
+// come back as themselves when they play again.
+func (d *Database) GetPeople() ([]*Person, error) {
+	rows, err := d.DB.Query(`
+		SELECT pe.id, pe.name, pe.created_at, COUNT(p.id)
+		FROM people pe
+		JOIN players p ON p.person_id = pe.id
+		GROUP BY pe.id
+		ORDER BY pe.name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query people: %w", err)
+	}
+	defer rows.Close()
+
+	var people []*Person
+	byID := make(map[int]*Person)
+	for rows.Next() {
+		person := &Person{}
+		if err := rows.Scan(&person.ID, &person.Name, &person.CreatedAt, &person.Games); err != nil {
+			return nil, fmt.Errorf("failed to scan person: %w", err)
+		}
+		people = append(people, person)
+		byID[person.ID] = person
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query people: %w", err)
+	}
+
+	aliases, err := d.DB.Query("SELECT person_id, alias FROM person_aliases ORDER BY alias")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query aliases: %w", err)
+	}
+	defer aliases.Close()
+
+	for aliases.Next() {
+		var personID int
+		var alias string
+		if err := aliases.Scan(&personID, &alias); err != nil {
+			return nil, fmt.Errorf("failed to scan alias: %w", err)
+		}
+		if person, ok := byID[personID]; ok {
+			person.Aliases = append(person.Aliases, alias)
+		}
+	}
+
+	return people, aliases.Err()
+}
+
+// FindPerson returns the person with the given name or alias, or ErrPersonNotFound
+func (d *Database) FindPerson(name string) (*Person, error) {
+	id, err := lookupPerson(d.DB, name)
+	if err != nil {
+		return nil, err
+	}
+	return d.getPerson(id)
+}
+
+// getPerson returns a person by ID with their aliases and number of games
+func (d *Database) getPerson(id int) (*Person, error) {
+	person := &Person{ID: id}
+	err := d.DB.QueryRow(`
+		SELECT pe.name, pe.created_at, (SELECT COUNT(*) FROM players p WHERE p.person_id = pe.id)
+		FROM people pe
+		WHERE pe.id = ?
+	`, id).Scan(&person.Name, &person.CreatedAt, &person.Games)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: ID %d", ErrPersonNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player %d: %w", id, err)
+	}
+
+	rows, err := d.DB.Query("SELECT alias FROM person_aliases WHERE person_id = ? ORDER BY alias", id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query aliases: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var alias string
+		if err := rows.Scan(&alias); err != nil {
+			return nil, fmt.Errorf("failed to scan alias: %w", err)
+		}
+		person.Aliases = append(person.Aliases, alias)
+	}
+	return person, rows.Err()
+}
+
+// AddAlias lets another name refer to a person, so games saved under it count for them.
+// Saved games already recorded under that name keep their current owner; use MergePeople for those.
+func (d *Database) AddAlias(personID int, alias string) error {
+	alias = NormalizeName(alias)
+	if alias == "" {
+		return fmt.Errorf("failed to add alias: name is empty")
+	}
+
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRow("SELECT EXISTS (SELECT 1 FROM people WHERE id = ?)", personID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to find player %d: %w", personID, err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: ID %d", ErrPersonNotFound, personID)
+	}
+
+	owner, err := lookupPerson(tx, alias)
+	switch {
+	case err == nil && owner == personID:
+		return nil
+	case err == nil:
+		return fmt.Errorf("%w: %q", ErrNameTaken, alias)
+	case !errors.Is(err, ErrPersonNotFound):
+		return err
+	}
+
+	if _, err := tx.Exec("INSERT INTO person_aliases (alias, person_id) VALUES (?, ?)", alias, personID); err != nil {
+		return fmt.Errorf("failed to add alias %q: %w", alias, err)
+	}
+	return tx.Commit()
+}
+
+// MergePeople moves every game, high score and alias of one person to another and removes
+// the first person. Their name becomes an alias, so it keeps finding the merged person.
+func (d *Database) MergePeople(fromID, intoID int) error {
+	if fromID == intoID {
+		return fmt.Errorf("failed to merge players: cannot merge a player into themselves")
+	}
+
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var fromName string
+	err = tx.QueryRow("SELECT name FROM people WHERE id = ?", fromID).Scan(&fromName)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%w: ID %d", ErrPersonNotFound, fromID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find player %d: %w", fromID, err)
+	}
+	var exists bool
+	if err := tx.QueryRow("SELECT EXISTS (SELECT 1 FROM people WHERE id = ?)", intoID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to find player %d: %w", intoID, err)
+	}
+	if !exists {
+		return fmt.Errorf("%w: ID %d", ErrPersonNotFound, intoID)
+	}
+
+	statements := []string{
+		"UPDATE players SET person_id = ? WHERE person_id = ?",
+		"UPDATE high_scores SET person_id = ? WHERE person_id = ?",
+		"UPDATE person_aliases SET person_id = ? WHERE person_id = ?",
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement, intoID, fromID); err != nil {
+			return fmt.Errorf("failed to reassign history of %q: %w", fromName, err)
+		}
+	}
+
+	if _, err := tx.Exec("DELETE FROM people WHERE id = ?", fromID); err != nil {
+		return fmt.Errorf("failed to remove player %q: %w", fromName, err)
+	}
+	if _, err := tx.Exec("INSERT INTO person_aliases (alias, person_id) VALUES (?, ?)", fromName, intoID); err != nil {
+		return fmt.Errorf("failed to keep %q as an alias: %w", fromName, err)
+	}
+
+	return tx.Commit()
+}
+
+// RenamePreview lists what renaming a person would change, so it can be shown before committing
+type RenamePreview struct {
+	Person     *Person
+	NewName    string
+	OldNames   []string       // spellings in saved games that will be replaced, like "sam " or a merged name
+	Games      []*GameSummary // games the person played, newest first
+	HighScores []*HighScore   // high score rows recorded under the person
+}
+
+// checkRename normalizes a new name for a person and makes sure nobody else uses it
+func checkRename(q rowQuerier, personID int, newName string) (string, error) {
+	newName = NormalizeName(newName)
+	if newName == "" {
+		return "", fmt.Errorf("failed to rename player: name is empty")
+	}
+	owner, err := lookupPerson(q, newName)
+	if err == nil && owner != personID {
+		return "", fmt.Errorf("%w: %q", ErrNameTaken, newName)
+	}
+	if err != nil && !errors.Is(err, ErrPersonNotFound) {
+		return "", err
+	}
+	return newName, nil
+}
+
+// PreviewRename returns the games and high scores that RenamePerson would change, without changing them
+func (d *Database) PreviewRename(personID int, newName string) (*RenamePreview, error) {
+	person, err := d.getPerson(personID)
+	if err != nil {
+		return nil, err
+	}
+	newName, err = checkRename(d.DB, personID, newName)
+	if err != nil {
+		return nil, err
+	}
+	preview := &RenamePreview{Person: person, NewName: newName}
+
+	names, err := d.DB.Query("SELECT DISTINCT name FROM players WHERE person_id = ? AND name != ? COLLATE BINARY ORDER BY name", personID, newName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query saved names: %w", err)
+	}
+	defer names.Close()
+	for names.Next() {
+		var name string
+		if err := names.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan saved name: %w", err)
+		}
+		preview.OldNames = append(preview.OldNames, name)
+	}
+	if err := names.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query saved names: %w", err)
+	}
+
+	games, err := d.DB.Query(`
+		SELECT uuid, created_at, player_count, winner_name, winner_score, ruleset, solo_rating
+		FROM games
+		WHERE EXISTS (SELECT 1 FROM players p WHERE p.game_id = games.id AND p.person_id = ?)
+		ORDER BY created_at DESC
+	`, personID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query games of %q: %w", person.Name, err)
+	}
+	defer games.Close()
+	for games.Next() {
+		game := &GameSummary{}
+		err := games.Scan(&game.ID, &game.CreatedAt, &game.PlayerCount, &game.WinnerName, &game.WinnerScore, &game.Ruleset, &game.SoloRating)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan game: %w", err)
+		}
+		preview.Games = append(preview.Games, game)
+	}
+	if err := games.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query games of %q: %w", person.Name, err)
+	}
+
+	highScores, err := d.DB.Query(`
+		SELECT id, game_id, player_name, score, achieved_at
+		FROM high_scores
+		WHERE person_id = ?
+		ORDER BY score DESC, achieved_at ASC
+	`, personID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query high scores of %q: %w", person.Name, err)
+	}
+	defer highScores.Close()
+	for highScores.Next() {
+		hs := &HighScore{}
+		if err := highScores.Scan(&hs.ID, &hs.GameID, &hs.PlayerName, &hs.Score, &hs.AchievedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan high score: %w", err)
+		}
+		preview.HighScores = append(preview.HighScores, hs)
+	}
+	return preview, highScores.Err()
+}
+
+// RenamePerson renames a person and rewrites their name in every saved game, winner and
+// high score in one transaction. The old name is dropped rather than kept as an alias,
+// since renaming is meant for fixing typos; add an alias afterwards to keep it.
+func (d *Database) RenamePerson(personID int, newName string) error {
+	tx, err := d.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var oldName string
+	err = tx.QueryRow("SELECT name FROM people WHERE id = ?", personID).Scan(&oldName)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%w: ID %d", ErrPersonNotFound, personID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find player %d: %w", personID, err)
+	}
+	newName, err = checkRename(tx, personID, newName)
+	if err != nil {
+		return err
+	}
+
+	statements := []struct{ query, what string }{
+		{"DELETE FROM person_aliases WHERE alias = ? AND person_id = ?", "aliases"},
+		{"UPDATE people SET name = ? WHERE id = ?", "player"},
+		{"UPDATE players SET name = ? WHERE person_id = ?", "saved games"},
+		{"UPDATE high_scores SET player_name = ? WHERE person_id = ?", "high scores"},
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(statement.query, newName, personID); err != nil {
+			return fmt.Errorf("failed to rename %q in %s: %w", oldName, statement.what, err)
+		}
+	}
+
+	// Winner names of the games they won are stored as text, so rebuild them
+	rows, err := tx.Query(`
+		SELECT DISTINCT game_id FROM players WHERE person_id = ? AND winner = TRUE
+	`, personID)
+	if err != nil {
+		return fmt.Errorf("failed to query games won by %q: %w", oldName, err)
+	}
+	var gameIDs []int
+	for rows.Next() {
+		var gameID int
+		if err := rows.Scan(&gameID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan game ID: %w", err)
+		}
+		gameIDs = append(gameIDs, gameID)
+	}
+	rows.Close()
+
+	for _, gameID := range gameIDs {
+		if err := updateWinnerName(tx, gameID); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// updateWinnerName rewrites a game's winner name from the names of its winning players
+func updateWinnerName(tx *sql.Tx, gameID int) error {
+	rows, err := tx.Query("SELECT name FROM players WHERE game_id = ? AND winner = TRUE ORDER BY id ASC", gameID)
+	if err != nil {
+		return fmt.Errorf("failed to query winners of game %d: %w", gameID, err)
+	}
+	var winners []*Player
+	for rows.Next() {
+		winner := &Player{}
+		if err := rows.Scan(&winner.Name); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan winner: %w", err)
+		}
+		winners = append(winners, winner)
+	}
+	rows.Close()
+
+	if _, err := tx.Exec("UPDATE games SET winner_name = ? WHERE id = ?", winnerNames(winners), gameID); err != nil {
+		return fmt.Errorf("failed to update winner of game %d: %w", gameID, err)
+	}
+	return nil
+}
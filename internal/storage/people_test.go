@@ -0,0 +1,318 @@
+package storage
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+// saveTestGame saves a game between the named players; the first one wins
+func saveTestGame(t *testing.T, db *Database, names ...string) *GameSession {
+	t.Helper()
+	var players []*game.Player
+	for i, name := range names {
+		greens := []int{1, 2, 3, 4, 5, 6}[:len(names)-i]
+		players = append(players, markedPlayer(t, name, greens...))
+	}
+	session := NewGameSession(players, "")
+	if err := db.SaveGame(session); err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+	return session
+}
+
+func findPerson(t *testing.T, db *Database, name string) *Person {
+	t.Helper()
+	person, err := db.FindPerson(name)
+	if err != nil {
+		t.Fatalf("FindPerson(%q): %v", name, err)
+	}
+	return person
+}
+
+func gamesPlayed(t *testing.T, db *Database, name string) int {
+	t.Helper()
+	stats, err := db.GetPlayerStatistics(name)
+	if err != nil {
+		t.Fatalf("GetPlayerStatistics(%q): %v", name, err)
+	}
+	return stats["total_games"].(int)
+}
+
+func TestNamesDifferingInCaseAndSpacesAreOnePerson(t *testing.T) {
+	db := openTestDatabase(t)
+	saveTestGame(t, db, "Sam", "Alice")
+	saveTestGame(t, db, "sam ", "Al")
+	saveTestGame(t, db, "  SAM", "alice")
+
+	sam := findPerson(t, db, "sAm")
+	if sam.Name != "Sam" || sam.Games != 3 {
+		t.Errorf("Sam = %q with %d games, want \"Sam\" with 3", sam.Name, sam.Games)
+	}
+	if got := gamesPlayed(t, db, " sam"); got != 3 {
+		t.Errorf("Sam played %d games, want 3", got)
+	}
+	// "Al" is a person of their own, not a prefix of "Alice"
+	if got := gamesPlayed(t, db, "Al"); got != 1 {
+		t.Errorf("Al played %d games, want 1", got)
+	}
+	if got := gamesPlayed(t, db, "ALICE"); got != 2 {
+		t.Errorf("Alice played %d games, want 2", got)
+	}
+
+	people, err := db.GetPeople()
+	if err != nil {
+		t.Fatalf("GetPeople: %v", err)
+	}
+	if len(people) != 3 {
+		t.Errorf("got %d people, want Al, Alice and Sam", len(people))
+	}
+}
+
+func TestAliasesFindThePerson(t *testing.T) {
+	db := openTestDatabase(t)
+	saveTestGame(t, db, "Samantha", "Bob")
+	samantha := findPerson(t, db, "Samantha")
+
+	if err := db.AddAlias(samantha.ID, " sammy "); err != nil {
+		t.Fatalf("AddAlias: %v", err)
+	}
+	if err := db.AddAlias(samantha.ID, "BOB"); err == nil {
+		t.Error("AddAlias accepted Bob's name as an alias of Samantha")
+	}
+
+	// Games saved under an alias count for the person
+	saveTestGame(t, db, "Sammy", "Bob")
+	if got := findPerson(t, db, "SAMMY"); got.ID != samantha.ID || got.Games != 2 {
+		t.Errorf("Sammy = person %d with %d games, want Samantha (%d) with 2", got.ID, got.Games, samantha.ID)
+	}
+
+	highScores, err := db.GetPlayerHighScores("sammy", 10)
+	if err != nil {
+		t.Fatalf("GetPlayerHighScores: %v", err)
+	}
+	if len(highScores) != 2 {
+		t.Fatalf("got %d high scores through the alias, want 2", len(highScores))
+	}
+	for _, hs := range highScores {
+		if hs.PlayerName != "Samantha" {
+			t.Errorf("high score listed as %q, want the person's name Samantha", hs.PlayerName)
+		}
+	}
+
+	games, total, err := db.GetGames(GameFilter{PlayerName: "Sammy"}, 10, 0)
+	if err != nil {
+		t.Fatalf("GetGames: %v", err)
+	}
+	if total != 2 || len(games) != 2 {
+		t.Errorf("filtering by the alias found %d games (total %d), want 2", len(games), total)
+	}
+}
+
+func TestMergePeople(t *testing.T) {
+	db := openTestDatabase(t)
+	saveTestGame(t, db, "Sam", "Bob")
+	saveTestGame(t, db, "Samuel", "Bob")
+	saveTestGame(t, db, "Bob", "Samuel")
+	sam := findPerson(t, db, "Sam")
+	samuel := findPerson(t, db, "Samuel")
+	if err := db.AddAlias(samuel.ID, "Sammy"); err != nil {
+		t.Fatalf("AddAlias: %v", err)
+	}
+
+	if err := db.MergePeople(samuel.ID, sam.ID); err != nil {
+		t.Fatalf("MergePeople: %v", err)
+	}
+
+	merged := findPerson(t, db, "Sam")
+	if merged.Games != 3 {
+		t.Errorf("Sam has %d games after the merge, want 3", merged.Games)
+	}
+	if !slices.Contains(merged.Aliases, "Samuel") || !slices.Contains(merged.Aliases, "Sammy") {
+		t.Errorf("Sam's aliases = %v, want Samuel and Samuel's alias Sammy", merged.Aliases)
+	}
+	if got := findPerson(t, db, "samuel"); got.ID != sam.ID {
+		t.Errorf("Samuel finds person %d, want Sam (%d)", got.ID, sam.ID)
+	}
+
+	var players, highScores int
+	if err := db.DB.QueryRow("SELECT COUNT(*) FROM players WHERE person_id = ?", sam.ID).Scan(&players); err != nil {
+		t.Fatalf("failed to count players: %v", err)
+	}
+	if err := db.DB.QueryRow("SELECT COUNT(*) FROM high_scores WHERE person_id = ?", sam.ID).Scan(&highScores); err != nil {
+		t.Fatalf("failed to count high scores: %v", err)
+	}
+	if players != 3 || highScores != 2 {
+		t.Errorf("Sam owns %d player rows and %d high scores, want 3 and 2", players, highScores)
+	}
+	if got := gamesPlayed(t, db, "Samuel"); got != 3 {
+		t.Errorf("statistics through the merged name count %d games, want 3", got)
+	}
+
+	people, err := db.GetPeople()
+	if err != nil {
+		t.Fatalf("GetPeople: %v", err)
+	}
+	for _, person := range people {
+		if person.ID == samuel.ID {
+			t.Error("Samuel is still listed after the merge")
+		}
+	}
+
+	if err := db.MergePeople(sam.ID, sam.ID); err == nil {
+		t.Error("MergePeople merged a person into themselves")
+	}
+}
+
+func TestPeopleWithoutGamesAreNotListed(t *testing.T) {
+	db := openTestDatabase(t)
+	session := saveTestGame(t, db, "Carol", "Dave")
+	saveTestGame(t, db, "Dave")
+
+	if err := db.DeleteGame(session.ID); err != nil {
+		t.Fatalf("DeleteGame: %v", err)
+	}
+	people, err := db.GetPeople()
+	if err != nil {
+		t.Fatalf("GetPeople: %v", err)
+	}
+	if len(people) != 1 || people[0].Name != "Dave" {
+		t.Errorf("people = %+v, want only Dave", people)
+	}
+}
+
+func TestMigrateLinksNamesToPeople(t *testing.T) {
+	db := openBaselineDatabase(t)
+	if _, err := db.DB.Exec(`
+		INSERT INTO players (game_id, name, final_score, winner) VALUES (1, 'sam ', 10, FALSE)
+	`); err != nil {
+		t.Fatalf("failed to add player: %v", err)
+	}
+	if err := db.migrate(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	var unlinked int
+	err := db.DB.QueryRow(`
+		SELECT (SELECT COUNT(*) FROM players WHERE person_id IS NULL)
+			+ (SELECT COUNT(*) FROM high_scores WHERE person_id IS NULL)
+	`).Scan(&unlinked)
+	if err != nil {
+		t.Fatalf("failed to count unlinked rows: %v", err)
+	}
+	if unlinked != 0 {
+		t.Errorf("%d players or high scores are not linked to a person", unlinked)
+	}
+	if sam := findPerson(t, db, "SAM"); sam.Games != 2 {
+		t.Errorf("Sam has %d games, want \"Sam\" and \"sam \" counted together", sam.Games)
+	}
+}
+
+func TestRenamePerson(t *testing.T) {
+	db := openTestDatabase(t)
+	won := saveTestGame(t, db, "Sma", "Bob")
+	saveTestGame(t, db, "Bob", "sma ")
+
+	// Identical sheets tie on every count, so Smaa and Bob share the win
+	players := []*game.Player{markedPlayer(t, "Smaa", 1, 2), markedPlayer(t, "Bob", 1, 2)}
+	shared := NewGameSession(players, "")
+	if err := db.SaveGame(shared); err != nil {
+		t.Fatalf("SaveGame: %v", err)
+	}
+	sma := findPerson(t, db, "Sma")
+	smaa := findPerson(t, db, "Smaa")
+	if err := db.MergePeople(smaa.ID, sma.ID); err != nil {
+		t.Fatalf("MergePeople: %v", err)
+	}
+
+	preview, err := db.PreviewRename(sma.ID, "  Sam ")
+	if err != nil {
+		t.Fatalf("PreviewRename: %v", err)
+	}
+	if preview.NewName != "Sam" || len(preview.Games) != 3 || len(preview.HighScores) != 2 {
+		t.Errorf("preview = %q in %d games and %d high scores, want \"Sam\" in 3 games and 2 high scores",
+			preview.NewName, len(preview.Games), len(preview.HighScores))
+	}
+	if !slices.Equal(preview.OldNames, []string{"Sma", "Smaa", "sma "}) {
+		t.Errorf("preview old names = %q, want every saved spelling", preview.OldNames)
+	}
+	if got := findPerson(t, db, "Sma"); got.Name != "Sma" {
+		t.Errorf("preview renamed the person to %q", got.Name)
+	}
+
+	if err := db.RenamePerson(sma.ID, "  Sam "); err != nil {
+		t.Fatalf("RenamePerson: %v", err)
+	}
+
+	var stale int
+	err = db.DB.QueryRow(`
+		SELECT (SELECT COUNT(*) FROM players WHERE person_id = ? AND name != 'Sam')
+			+ (SELECT COUNT(*) FROM high_scores WHERE person_id = ? AND player_name != 'Sam')
+	`, sma.ID, sma.ID).Scan(&stale)
+	if err != nil {
+		t.Fatalf("failed to count old names: %v", err)
+	}
+	if stale != 0 {
+		t.Errorf("%d players or high scores still have an old name", stale)
+	}
+	winners := map[string]string{won.ID: "Sam", shared.ID: "Sam & Bob"}
+	for gameID, want := range winners {
+		var winnerName string
+		if err := db.DB.QueryRow("SELECT winner_name FROM games WHERE uuid = ?", gameID).Scan(&winnerName); err != nil {
+			t.Fatalf("failed to read winner name: %v", err)
+		}
+		if winnerName != want {
+			t.Errorf("winner of game %s = %q, want %q", gameID, winnerName, want)
+		}
+	}
+	if got := findPerson(t, db, "sam"); got.ID != sma.ID || got.Name != "Sam" || got.Games != 3 {
+		t.Errorf("Sam = person %d %q with %d games, want %d \"Sam\" with 3", got.ID, got.Name, got.Games, sma.ID)
+	}
+	if got := findPerson(t, db, "Bob"); got.Games != 3 {
+		t.Errorf("Bob has %d games after the rename, want 3", got.Games)
+	}
+}
+
+func TestRenamePersonToCaseVariant(t *testing.T) {
+	db := openTestDatabase(t)
+	saveTestGame(t, db, "sam", "Bob")
+	sam := findPerson(t, db, "sam")
+
+	if err := db.RenamePerson(sam.ID, "Sam"); err != nil {
+		t.Fatalf("RenamePerson: %v", err)
+	}
+	if got := findPerson(t, db, "SAM"); got.ID != sam.ID || got.Name != "Sam" {
+		t.Errorf("person = %d %q, want %d \"Sam\"", got.ID, got.Name, sam.ID)
+	}
+	var name string
+	if err := db.DB.QueryRow("SELECT name FROM players WHERE person_id = ?", sam.ID).Scan(&name); err != nil {
+		t.Fatalf("failed to read player name: %v", err)
+	}
+	if name != "Sam" {
+		t.Errorf("saved player name = %q, want \"Sam\"", name)
+	}
+}
+
+func TestRenamePersonToTakenName(t *testing.T) {
+	db := openTestDatabase(t)
+	saveTestGame(t, db, "Sam", "Bob")
+	sam := findPerson(t, db, "Sam")
+	bob := findPerson(t, db, "Bob")
+	if err := db.AddAlias(bob.ID, "Robert"); err != nil {
+		t.Fatalf("AddAlias: %v", err)
+	}
+
+	for _, name := range []string{"bob", "ROBERT"} {
+		if _, err := db.PreviewRename(sam.ID, name); !errors.Is(err, ErrNameTaken) {
+			t.Errorf("PreviewRename(%q) error = %v, want ErrNameTaken", name, err)
+		}
+		if err := db.RenamePerson(sam.ID, name); !errors.Is(err, ErrNameTaken) {
+			t.Errorf("RenamePerson(%q) error = %v, want ErrNameTaken", name, err)
+		}
+	}
+	if got := findPerson(t, db, "Sam"); got.ID != sam.ID {
+		t.Errorf("Sam was renamed after a refused rename")
+	}
+}
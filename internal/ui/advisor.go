@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"thats-pretty-clever-scorer/internal/game"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// adviceCount is the number of suggestions shown by the advisor
+const adviceCount = 3
+
+// CreateAdvisorPanel creates the move advisor: type in a roll, tick the dice the player may
+// take, and it lists the best picks with the final score each is expected to lead to
+func CreateAdvisorPanel(gm *GameManager) fyne.CanvasObject {
+	if !gm.Ruleset.MarkLevel() || len(gm.Players) == 0 {
+		return widget.NewLabel("")
+	}
+
+	playerNames := make([]string, len(gm.Players))
+	for i, player := range gm.Players {
+		playerNames[i] = player.Name
+	}
+	playerSelect := widget.NewSelect(playerNames, nil)
+	playerSelect.SetSelectedIndex(0)
+	if active := gm.activePlayerIndex(); active >= 0 {
+		playerSelect.SetSelectedIndex(active)
+	}
+
+	dieValues := []string{"1", "2", "3", "4", "5", "6"}
+	valueSelects := make(map[game.Color]*widget.Select)
+	availableChecks := make(map[game.Color]*widget.Check)
+	diceGrid := container.NewGridWithColumns(2)
+	for _, color := range game.DiceColors() {
+		valueSelect := widget.NewSelect(dieValues, nil)
+		valueSelect.PlaceHolder = "–"
+		check := widget.NewCheck(game.AreaName(color), nil)
+		check.SetChecked(true)
+		valueSelects[color] = valueSelect
+		availableChecks[color] = check
+		diceGrid.Add(check)
+		diceGrid.Add(valueSelect)
+	}
+
+	resultLabel := widget.NewLabel("Enter the roll and tap Suggest")
+	resultLabel.Wrapping = fyne.TextWrapWord
+
+	var suggestBtn *widget.Button
+	suggestBtn = widget.NewButton("💡 Suggest", func() {
+		index := playerSelect.SelectedIndex()
+		if index < 0 || index >= len(gm.Players) {
+			return
+		}
+
+		dice := make(game.Dice)
+		var available []game.Color
+		for _, color := range game.DiceColors() {
+			value, err := strconv.Atoi(valueSelects[color].Selected)
+			if err != nil {
+				resultLabel.SetText("Enter a value for every die, the blue area also needs the white die")
+				return
+			}
+			dice[color] = value
+			if availableChecks[color].Checked {
+				available = append(available, color)
+			}
+		}
+
+		// Simulate on a snapshot so edits made meanwhile do not race with the advisor
+		player := gm.Players[index]
+		snapshot := &game.Player{Name: player.Name, ScoreSheet: player.ScoreSheet.Clone()}
+		situation := game.Situation{}
+		if gm.Rounds != nil {
+			situation = gm.Rounds.Situation()
+		}
+
+		suggestBtn.Disable()
+		resultLabel.SetText("Simulating the rest of the game…")
+		go func() {
+			advisor := game.NewAdvisor(newRoller(), game.DefaultAdvisorRollouts)
+			suggestions, err := advisor.Suggest(snapshot, dice, available, situation, adviceCount)
+			fyne.Do(func() {
+				suggestBtn.Enable()
+				if err != nil {
+					resultLabel.SetText(fmt.Sprintf("Could not simulate the game: %v", err))
+					return
+				}
+				resultLabel.SetText(formatSuggestions(suggestions))
+			})
+		}()
+	})
+	suggestBtn.Importance = widget.MediumImportance
+
+	return widget.NewAccordion(widget.NewAccordionItem("💡 Move Advisor", container.NewVBox(
+		container.NewBorder(nil, nil, widget.NewLabel("For:"), nil, playerSelect),
+		diceGrid,
+		suggestBtn,
+		resultLabel,
+	)))
+}
+
+// formatSuggestions lists the suggestions best first with their expected final score
+func formatSuggestions(suggestions []game.Suggestion) string {
+	if len(suggestions) == 0 {
+		return "No legal pick for this roll"
+	}
+	lines := make([]string, 0, len(suggestions))
+	for i, suggestion := range suggestions {
+		line := fmt.Sprintf("%d. %s · expected %.1f pts", i+1, suggestion.Placement, suggestion.Expected)
+		if len(suggestion.Rewards) > 0 {
+			line += " · " + formatRewards(suggestion.Rewards)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// activePlayerIndex returns the index of the active player, or -1 when rounds are not tracked
+func (gm *GameManager) activePlayerIndex() int {
+	if gm.Rounds == nil || gm.Rounds.Finished {
+		return -1
+	}
+	return gm.Rounds.ActiveIndex
+}
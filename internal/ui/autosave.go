@@ -0,0 +1,126 @@
+package ui
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"thats-pretty-clever-scorer/internal/game"
+	"thats-pretty-clever-scorer/internal/storage"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// NewGameManagerFromState creates a game manager that carries on with a saved game.
+// The undo history starts empty, since it is not part of the saved state.
+func NewGameManagerFromState(state *game.GameState) *GameManager {
+	gm := NewGameManager()
+	gm.Ruleset = state.Ruleset
+	gm.Players = state.Players
+	gm.Rounds = state.Rounds
+	return gm
+}
+
+// State returns everything needed to resume the game later
+func (gm *GameManager) State() *game.GameState {
+	return &game.GameState{Ruleset: gm.Ruleset, Players: gm.Players, Rounds: gm.Rounds}
+}
+
+// SetAutosave checkpoints the game with save after every change, nil stops checkpointing
+func (gm *GameManager) SetAutosave(save func(state *game.GameState) error) {
+	gm.autosave = save
+}
+
+// checkpoint saves the game through the autosave callback, if one is set
+func (gm *GameManager) checkpoint() {
+	if gm.autosave == nil || len(gm.Players) == 0 {
+		return
+	}
+	if err := gm.autosave(gm.State()); err != nil {
+		slog.Error("Error saving game in progress", "error", err)
+	}
+}
+
+// describeDraft summarises a game in progress, like "Alice, Bob · round 3 of 6"
+func describeDraft(draft *storage.Draft) string {
+	names := make([]string, 0, len(draft.State.Players))
+	for _, player := range draft.State.Players {
+		names = append(names, player.Name)
+	}
+	text := strings.Join(names, ", ")
+	if rounds := draft.State.Rounds; rounds != nil {
+		if rounds.Finished {
+			text += " · all rounds played"
+		} else {
+			text += fmt.Sprintf(" · round %d of %d", rounds.Round, rounds.TotalRounds)
+		}
+	}
+	return text + "\nLast played " + draft.UpdatedAt.Format("January 2 at 3:04 PM")
+}
+
+// ShowResumeDialog offers to carry on with the game in progress, if there is one.
+// Discarding removes the draft, Later keeps it for the Resume Game button.
+func ShowResumeDialog(db *storage.Database, window fyne.Window, onResume func(gm *GameManager)) {
+	draft, err := db.LoadDraft()
+	if err != nil {
+		slog.Error("Error loading game in progress", "error", err)
+		return
+	}
+	if draft == nil {
+		return
+	}
+
+	var resumeDialog dialog.Dialog
+	resumeBtn := widget.NewButton("▶️ Resume", func() {
+		resumeDialog.Hide()
+		onResume(NewGameManagerFromState(draft.State))
+	})
+	resumeBtn.Importance = widget.HighImportance
+	laterBtn := widget.NewButton("Later", func() {
+		resumeDialog.Hide()
+	})
+	discardBtn := widget.NewButton("🗑️ Discard", func() {
+		resumeDialog.Hide()
+		if err := db.ClearDraft(); err != nil {
+			dialog.ShowError(fmt.Errorf("Failed to discard game: %v", err), window)
+		}
+	})
+	discardBtn.Importance = widget.DangerImportance
+
+	content := container.NewVBox(
+		widget.NewLabel("You have a game in progress:"),
+		widget.NewLabelWithStyle(describeDraft(draft), fyne.TextAlignLeading, fyne.TextStyle{Italic: true}),
+		container.NewHBox(discardBtn, laterBtn, resumeBtn),
+	)
+	resumeDialog = dialog.NewCustomWithoutButtons("Resume Game", content, window)
+	resumeDialog.Show()
+}
+
+// ConfirmReplaceDraft asks before a new game takes over the draft of an unfinished one, since
+// there is only room for one. It goes straight on when there is no draft, or when the draft is
+// this game's own because it is already being checkpointed.
+func ConfirmReplaceDraft(db *storage.Database, gm *GameManager, window fyne.Window, onConfirm func()) {
+	if gm.autosave != nil {
+		onConfirm()
+		return
+	}
+	draft, err := db.LoadDraft()
+	if err != nil {
+		slog.Error("Error loading game in progress", "error", err)
+	}
+	if draft == nil {
+		onConfirm()
+		return
+	}
+
+	message := "Starting this game replaces your game in progress:\n\n" + describeDraft(draft) +
+		"\n\nIt can no longer be resumed. Start anyway?"
+	dialog.ShowConfirm("Replace Game in Progress", message, func(confirmed bool) {
+		if confirmed {
+			onConfirm()
+		}
+	}, window)
+}
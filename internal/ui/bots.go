@@ -0,0 +1,184 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+// ErrBotsNeedMarks is returned when adding a bot to an edition scored by typed totals
+var ErrBotsNeedMarks = errors.New("bots can only play editions scored box by box")
+
+// newRoller creates the dice used by bots
+func newRoller() game.Roller {
+	return rand.New(rand.NewPCG(uint64(time.Now().UnixNano()), 0))
+}
+
+// AddBot adds a computer player using one of the built-in strategies
+func (gm *GameManager) AddBot(strategyID string) error {
+	if !gm.Ruleset.MarkLevel() {
+		return ErrBotsNeedMarks
+	}
+	strategy, err := game.NewStrategy(strategyID, gm.Roller)
+	if err != nil {
+		return err
+	}
+
+	name := "🤖 " + strategy.Name()
+	count := 1
+	for _, player := range gm.Players {
+		if player.IsBot() && player.Bot.ID() == strategyID {
+			count++
+		}
+	}
+	if count > 1 {
+		name = fmt.Sprintf("%s %d", name, count)
+	}
+
+	gm.Players = append(gm.Players, game.NewBot(name, strategy, gm.Ruleset))
+	return nil
+}
+
+// AdvanceTurn passes the turn to the next player and lets the bots play. After a human's
+// turn each bot picks from a simulated platter, since the real dice are not known. Bots place
+// their round-start free marks and play their own turns in full. In a solo game the passive
+// phase is simulated by rolling the dice the player may pick from. TurnLog describes it all.
+func (gm *GameManager) AdvanceTurn() error {
+	if gm.Rounds == nil {
+		return game.ErrNoPlayers
+	}
+	gm.TurnLog = nil
+	defer gm.checkpoint()
+
+	previous := gm.Rounds.ActivePlayer()
+	round := gm.Rounds.Round
+	if previous != nil && game.IsSolo(gm.Players) {
+		gm.playSoloPassive(previous)
+	} else if previous != nil && !previous.IsBot() {
+		turn := gm.passiveTurn()
+		for _, bot := range turn.Passive {
+			gm.playBot(turn, bot)
+		}
+	}
+
+	over, err := gm.Rounds.NextTurn()
+	if err != nil || over {
+		return err
+	}
+
+	if gm.Rounds.Round != round {
+		for _, bot := range gm.bots() {
+			gm.placeRoundRewards(bot)
+		}
+	}
+	gm.playBotTurn()
+	return nil
+}
+
+// passiveTurn simulates the platter the bots pick from after a human's turn. It carries the
+// current round, so strategies that look ahead know how many rounds are left.
+func (gm *GameManager) passiveTurn() *game.Turn {
+	turn := game.NewPassiveTurn(gm.bots(), gm.Roller)
+	turn.Round = gm.Rounds.Round
+	turn.TotalRounds = gm.Rounds.TotalRounds
+	return turn
+}
+
+// playBotTurn plays the active player's turn when it is a bot. Other bots pick from the
+// platter it leaves, and the platter is logged so human players can pick from it too.
+func (gm *GameManager) playBotTurn() {
+	active := gm.Rounds.ActivePlayer()
+	if active == nil || !active.IsBot() {
+		return
+	}
+
+	turn, err := gm.Rounds.PlayTurn(gm.Roller)
+	if err != nil {
+		return
+	}
+	gm.playBot(turn, active)
+	for _, player := range turn.Passive {
+		if player.IsBot() {
+			gm.playBot(turn, player)
+		}
+	}
+	gm.TurnLog = append(gm.TurnLog, "🥈 Silver platter: "+formatDice(turn.Dice, turn.Platter))
+}
+
+// playSoloPassive rolls the discarded dice of a solo round. A bot picks from them itself,
+// a human player is shown the platter to pick from.
+func (gm *GameManager) playSoloPassive(player *game.Player) {
+	turn := game.NewSoloPassiveTurn(player, gm.Rounds, gm.Roller)
+	if player.IsBot() {
+		gm.playBot(turn, player)
+		return
+	}
+	gm.TurnLog = append(gm.TurnLog, "🥈 Solo passive pick, choose one of: "+formatDice(turn.Dice, turn.Platter))
+}
+
+// playBot plays a bot's part of a turn as one undoable change and logs its moves
+func (gm *GameManager) playBot(turn *game.Turn, bot *game.Player) {
+	var moves []game.Move
+	err := gm.Edit(bot, "bot turn", func() error {
+		var err error
+		moves, err = game.PlayBot(turn, bot)
+		return err
+	})
+	gm.logBotMoves(bot, moves, err)
+}
+
+// placeRoundRewards lets a bot place the free marks handed out at the start of a round
+func (gm *GameManager) placeRoundRewards(bot *game.Player) {
+	rewards := game.RoundRewards(gm.Rounds.Round)
+	var moves []game.Move
+	err := gm.Edit(bot, "bot round reward", func() error {
+		var err error
+		moves, err = game.PlaceFreeMarks(bot, nil, rewards)
+		return err
+	})
+	if len(moves) > 0 || err != nil {
+		gm.logBotMoves(bot, moves, err)
+	}
+}
+
+func (gm *GameManager) logBotMoves(bot *game.Player, moves []game.Move, err error) {
+	if err != nil {
+		gm.TurnLog = append(gm.TurnLog, fmt.Sprintf("%s: %v", bot.Name, err))
+		return
+	}
+	if len(moves) == 0 {
+		gm.TurnLog = append(gm.TurnLog, bot.Name+": passes")
+		return
+	}
+	picks := make([]string, 0, len(moves))
+	for _, move := range moves {
+		picks = append(picks, fmt.Sprintf("%s (%d)", move.Placement, move.Value))
+	}
+	gm.TurnLog = append(gm.TurnLog, bot.Name+": "+strings.Join(picks, ", "))
+}
+
+func (gm *GameManager) bots() []*game.Player {
+	var bots []*game.Player
+	for _, player := range gm.Players {
+		if player.IsBot() {
+			bots = append(bots, player)
+		}
+	}
+	return bots
+}
+
+// formatDice lists dice with their values, like "white 3, purple 1"
+func formatDice(dice game.Dice, colors []game.Color) string {
+	if len(colors) == 0 {
+		return "empty"
+	}
+	parts := make([]string, 0, len(colors))
+	for _, color := range colors {
+		parts = append(parts, fmt.Sprintf("%s %d", color, dice[color]))
+	}
+	return strings.Join(parts, ", ")
+}
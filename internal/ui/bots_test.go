@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"testing"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+func TestPassiveTurnKnowsTheRound(t *testing.T) {
+	gm := NewGameManager()
+	gm.AddPlayer("Alice")
+	if err := gm.AddBot(game.StrategyMonteCarlo); err != nil {
+		t.Fatalf("AddBot: %v", err)
+	}
+	if err := gm.StartRounds(nil, nil); err != nil {
+		t.Fatalf("StartRounds: %v", err)
+	}
+
+	situation := gm.passiveTurn().Situation()
+	if situation.Round == 0 || situation.TotalRounds == 0 {
+		t.Fatalf("passive turn situation = %+v, want the current round and round count", situation)
+	}
+	if situation.Round != gm.Rounds.Round || situation.TotalRounds != gm.Rounds.TotalRounds {
+		t.Errorf("passive turn situation = %+v, want round %d of %d", situation, gm.Rounds.Round, gm.Rounds.TotalRounds)
+	}
+}
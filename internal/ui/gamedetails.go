@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"log/slog"
 	"sort"
+	"strconv"
+	"strings"
 
+	gameplay "thats-pretty-clever-scorer/internal/game"
 	"thats-pretty-clever-scorer/internal/storage"
 
 	"fyne.io/fyne/v2"
@@ -59,10 +62,16 @@ func CreateGameDetailsScreen(db *storage.Database, gameID string, onBack func(),
 	metadataContainer := container.NewVBox(
 		widget.NewLabelWithStyle("Game Information", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		widget.NewLabel(fmt.Sprintf("Date: %s", dateText)),
+		widget.NewLabel(fmt.Sprintf("Edition: %s", gameplay.RulesetByID(game.Ruleset).Name())),
 		widget.NewLabel(fmt.Sprintf("Players: %d", len(game.Players))),
-		widget.NewLabel(fmt.Sprintf("Notes: %s", notesText)),
-		widget.NewSeparator(),
 	)
+	if game.SoloRating != "" {
+		metadataContainer.Add(widget.NewLabel(fmt.Sprintf("Solo rating: %s", game.SoloRating)))
+	}
+	metadataContainer.Add(widget.NewLabel(fmt.Sprintf("Notes: %s", notesText)))
+	metadataContainer.Add(widget.NewSeparator())
+
+	ruleset := gameplay.RulesetByID(game.Ruleset)
 
 	// Sort players by score (highest first)
 	players := make([]*storage.Player, len(game.Players))
@@ -73,8 +82,8 @@ func CreateGameDetailsScreen(db *storage.Database, gameID string, onBack func(),
 
 	// Create player cards with section breakdowns
 	var playerCards []fyne.CanvasObject
-	for i, player := range players {
-		card := createPlayerDetailCard(player, i == 0) // First player is winner
+	for _, player := range players {
+		card := createPlayerDetailCard(player, ruleset, player.Winner)
 		playerCards = append(playerCards, card)
 		playerCards = append(playerCards, widget.NewSeparator())
 	}
@@ -105,7 +114,7 @@ func CreateGameDetailsScreen(db *storage.Database, gameID string, onBack func(),
 }
 
 // createPlayerDetailCard creates a read-only card showing player's section scores
-func createPlayerDetailCard(player *storage.Player, isWinner bool) fyne.CanvasObject {
+func createPlayerDetailCard(player *storage.Player, ruleset gameplay.Ruleset, isWinner bool) fyne.CanvasObject {
 
 	// Player name with winner indicator
 	nameText := player.Name
@@ -115,20 +124,11 @@ func createPlayerDetailCard(player *storage.Player, isWinner bool) fyne.CanvasOb
 	nameLabel := widget.NewLabelWithStyle(nameText, fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 
 	// Section scores with colored indicators (reuse existing color function)
-	yellowLabel := createColoredLabel("● Yellow:", "yellow")
-	yellowValue := widget.NewLabel(fmt.Sprintf("%d", player.YellowTotal))
-
-	greenLabel := createColoredLabel("● Green:", "green")
-	greenValue := widget.NewLabel(fmt.Sprintf("%d", player.GreenTotal))
-
-	orangeLabel := createColoredLabel("● Orange:", "orange")
-	orangeValue := widget.NewLabel(fmt.Sprintf("%d", player.OrangeTotal))
-
-	purpleLabel := createColoredLabel("● Purple:", "purple")
-	purpleValue := widget.NewLabel(fmt.Sprintf("%d", player.PurpleTotal))
-
-	blueLabel := createColoredLabel("● Blue:", "blue")
-	blueValue := widget.NewLabel(fmt.Sprintf("%d", player.BlueTotal))
+	scoreGrid := container.NewGridWithColumns(2)
+	for _, area := range ruleset.Areas() {
+		scoreGrid.Add(createColoredLabel("● "+gameplay.AreaName(area)+":", string(area)))
+		scoreGrid.Add(widget.NewLabel(fmt.Sprintf("%d", player.AreaTotal(area))))
+	}
 
 	// Foxes and bonus
 	foxLabel := widget.NewLabelWithStyle("🦊 Foxes:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
@@ -145,17 +145,12 @@ func createPlayerDetailCard(player *storage.Player, isWinner bool) fyne.CanvasOb
 	totalLabel := widget.NewLabelWithStyle("🎯 Total:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
 	totalValue := widget.NewLabelWithStyle(totalText, fyne.TextAlignTrailing, fyne.TextStyle{Bold: true})
 
-	// Create score grid
-	scoreGrid := container.NewGridWithColumns(2,
-		yellowLabel, yellowValue,
-		greenLabel, greenValue,
-		orangeLabel, orangeValue,
-		purpleLabel, purpleValue,
-		blueLabel, blueValue,
-		foxLabel, foxValue,
-		bonusLabel, bonusValue,
-		totalLabel, totalValue,
-	)
+	scoreGrid.Add(foxLabel)
+	scoreGrid.Add(foxValue)
+	scoreGrid.Add(bonusLabel)
+	scoreGrid.Add(bonusValue)
+	scoreGrid.Add(totalLabel)
+	scoreGrid.Add(totalValue)
 
 	// Create card container
 	card := container.NewVBox(
@@ -163,10 +158,80 @@ func createPlayerDetailCard(player *storage.Player, isWinner bool) fyne.CanvasOb
 		widget.NewSeparator(),
 		scoreGrid,
 	)
+	if marks := createSheetMarks(player.Sheet); marks != nil {
+		card.Add(marks)
+	}
 
 	return card
 }
 
+// createSheetMarks lists what was crossed off in each area of a saved mark-level sheet,
+// or returns nil when the game was saved with totals only
+func createSheetMarks(sheet *gameplay.ScoreSheet) fyne.CanvasObject {
+	if sheet == nil || !sheet.Ruleset.MarkLevel() {
+		return nil
+	}
+
+	track := func(values []int) string {
+		if len(values) == 0 {
+			return "—"
+		}
+		entries := make([]string, 0, len(values))
+		for _, value := range values {
+			if value == 0 {
+				entries = append(entries, "✖")
+			} else {
+				entries = append(entries, strconv.Itoa(value))
+			}
+		}
+		return strings.Join(entries, " ")
+	}
+
+	var yellowRows []string
+	for row := range gameplay.YellowSize {
+		var cells []string
+		for col := range gameplay.YellowSize {
+			if sheet.Yellow.IsMarked(row, col) {
+				cells = append(cells, "✖")
+			} else {
+				cells = append(cells, strconv.Itoa(gameplay.YellowNumber(row, col)))
+			}
+		}
+		yellowRows = append(yellowRows, strings.Join(cells, " "))
+	}
+
+	var blueSums []string
+	for sum := gameplay.BlueMinSum; sum <= gameplay.BlueMaxSum; sum++ {
+		if sheet.Blue.IsMarked(sum) {
+			blueSums = append(blueSums, strconv.Itoa(sum))
+		}
+	}
+	blue := "—"
+	if len(blueSums) > 0 {
+		blue = strings.Join(blueSums, ", ")
+	}
+
+	marks := container.NewGridWithColumns(2)
+	for _, line := range []struct {
+		area gameplay.Color
+		text string
+	}{
+		{gameplay.ColorYellow, strings.Join(yellowRows, " / ")},
+		{gameplay.ColorGreen, track(sheet.Green.Values)},
+		{gameplay.ColorOrange, track(sheet.Orange.Values)},
+		{gameplay.ColorPurple, track(sheet.Purple.Values)},
+		{gameplay.ColorBlue, blue},
+	} {
+		marks.Add(widget.NewLabel(gameplay.AreaName(line.area) + " marks:"))
+		marks.Add(widget.NewLabel(line.text))
+	}
+
+	return container.NewVBox(
+		widget.NewLabelWithStyle("Score Sheet", fyne.TextAlignLeading, fyne.TextStyle{Italic: true}),
+		marks,
+	)
+}
+
 // showDeleteConfirmation shows a confirmation dialog before deleting a game
 func showDeleteConfirmation(db *storage.Database, gameID string, onBack func(), window fyne.Window) {
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	gameplay "thats-pretty-clever-scorer/internal/game"
 	"thats-pretty-clever-scorer/internal/storage"
 
 	"fyne.io/fyne/v2"
@@ -115,8 +116,12 @@ func createGameCard(game *storage.GameSummary, onGameSelected func(gameID string
 		winnerText = "No Winner"
 	}
 
+	if game.SoloRating != "" {
+		winnerText = fmt.Sprintf("🎯 %s · %s", game.WinnerName, game.SoloRating)
+	}
+
 	scoreText := fmt.Sprintf("%d pts", game.WinnerScore)
-	playersText := fmt.Sprintf("%d players", game.PlayerCount)
+	playersText := fmt.Sprintf("%d players · %s", game.PlayerCount, gameplay.RulesetByID(game.Ruleset).Name())
 
 	// Create labels
 	dateLabel := widget.NewLabelWithStyle(dateText, fyne.TextAlignLeading, fyne.TextStyle{})
@@ -40,6 +40,11 @@ func CreateMainMenu(app fyne.App, window fyne.Window, db *storage.Database, onSc
 	})
 	newGameBtn.Importance = widget.HighImportance
 
+	resumeBtn := widget.NewButton("▶️ Resume Game", func() {
+		onScreenChange("resume")
+	})
+	resumeBtn.Importance = widget.MediumImportance
+
 	historyBtn := widget.NewButton("📊 Game History", func() {
 		onScreenChange("history")
 	})
@@ -50,6 +55,11 @@ func CreateMainMenu(app fyne.App, window fyne.Window, db *storage.Database, onSc
 	})
 	highScoresBtn.Importance = widget.MediumImportance
 
+	peopleBtn := widget.NewButton("👥 Players", func() {
+		onScreenChange("people")
+	})
+	peopleBtn.Importance = widget.MediumImportance
+
 	cleanupBtn := widget.NewButton("🧹 Manage Data", func() {
 		onScreenChange("cleanup")
 	})
@@ -63,8 +73,10 @@ func CreateMainMenu(app fyne.App, window fyne.Window, db *storage.Database, onSc
 	// Create button container with consistent sizing
 	buttons := container.NewVBox(
 		newGameBtn,
+		resumeBtn,
 		historyBtn,
 		highScoresBtn,
+		peopleBtn,
 		cleanupBtn,
 		exitBtn,
 	)
@@ -97,69 +109,35 @@ func CreateHighScoresScreen(db *storage.Database, onBack func()) fyne.CanvasObje
 	// Get top 10 high scores asynchronously to avoid blocking UI
 	go func() {
 		highScores, err := db.GetHighScores(10)
-		if err != nil {
-			// Update UI with error
-			fyne.Do(func() {
-				errorContent := container.NewVBox(
-					widget.NewLabel("Error loading high scores"),
-					backBtn,
-				)
-				contentContainer.Objects[0] = container.NewPadded(errorContent)
-				contentContainer.Refresh()
-			})
-			return
-		}
-
-		// Create list of high scores
-		var scoreWidgets []fyne.CanvasObject
-
-		if len(highScores) == 0 {
-			scoreWidgets = append(scoreWidgets, widget.NewLabel("No high scores yet. Start playing!"))
-		} else {
-			for i, hs := range highScores {
-				rankText := fmt.Sprintf("#%d", i+1)
-				scoreText := fmt.Sprintf("%d pts", hs.Score)
-				playerText := hs.PlayerName
-				dateText := hs.AchievedAt.Format("2006-01-02")
-
-				// Create row with rank, player, score, and date
-				rankLabel := widget.NewLabelWithStyle(rankText, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
-				playerLabel := widget.NewLabel(playerText)
-				scoreLabel := widget.NewLabelWithStyle(scoreText, fyne.TextAlignTrailing, fyne.TextStyle{Bold: true})
-				dateLabel := widget.NewLabel(dateText)
-
-				// Color coding for top 3
-				if i == 0 {
-					rankLabel.SetText("🥇 " + rankText)
-				} else if i == 1 {
-					rankLabel.SetText("🥈 " + rankText)
-				} else if i == 2 {
-					rankLabel.SetText("🥉 " + rankText)
-				}
-
-				// Create row container
-				row := container.NewHBox(
-					container.NewVBox(rankLabel, dateLabel),
-					container.NewVBox(playerLabel),
-					container.NewVBox(scoreLabel),
-				)
-
-				scoreWidgets = append(scoreWidgets, row)
-				if i < len(highScores)-1 {
-					scoreWidgets = append(scoreWidgets, widget.NewSeparator())
-				}
+		if err == nil {
+			var soloScores []*storage.HighScore
+			soloScores, err = db.GetSoloHighScores(10)
+			if err == nil {
+				fyne.Do(func() {
+					// Create final content with back button
+					finalContent := container.NewVBox(
+						widget.NewLabelWithStyle("👥 Multiplayer", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+						container.NewVBox(createHighScoreRows(highScores, "No high scores yet. Start playing!")...),
+						widget.NewSeparator(),
+						widget.NewLabelWithStyle("🎯 Solo", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+						container.NewVBox(createHighScoreRows(soloScores, "No solo games yet.")...),
+						widget.NewSeparator(),
+						backBtn,
+					)
+					contentContainer.Objects[0] = container.NewPadded(finalContent)
+					contentContainer.Refresh()
+				})
+				return
 			}
 		}
 
-		// Update UI on main thread with complete content
+		// Update UI with error
 		fyne.Do(func() {
-			// Create final content with back button
-			finalContent := container.NewVBox(
-				container.NewVBox(scoreWidgets...),
-				widget.NewSeparator(),
+			errorContent := container.NewVBox(
+				widget.NewLabel("Error loading high scores"),
 				backBtn,
 			)
-			contentContainer.Objects[0] = container.NewPadded(finalContent)
+			contentContainer.Objects[0] = container.NewPadded(errorContent)
 			contentContainer.Refresh()
 		})
 	}()
@@ -167,3 +145,49 @@ func CreateHighScoresScreen(db *storage.Database, onBack func()) fyne.CanvasObje
 	// Return content container that will be updated
 	return contentContainer
 }
+
+// createHighScoreRows creates a ranked row for each high score, with the rating of solo games
+func createHighScoreRows(highScores []*storage.HighScore, emptyText string) []fyne.CanvasObject {
+	if len(highScores) == 0 {
+		return []fyne.CanvasObject{widget.NewLabel(emptyText)}
+	}
+
+	var scoreWidgets []fyne.CanvasObject
+	for i, hs := range highScores {
+		rankText := fmt.Sprintf("#%d", i+1)
+		scoreText := fmt.Sprintf("%d pts", hs.Score)
+		playerText := hs.PlayerName
+		if hs.SoloRating != "" {
+			playerText += " · " + hs.SoloRating
+		}
+		dateText := hs.AchievedAt.Format("2006-01-02")
+
+		// Create row with rank, player, score, and date
+		rankLabel := widget.NewLabelWithStyle(rankText, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+		playerLabel := widget.NewLabel(playerText)
+		scoreLabel := widget.NewLabelWithStyle(scoreText, fyne.TextAlignTrailing, fyne.TextStyle{Bold: true})
+		dateLabel := widget.NewLabel(dateText)
+
+		// Color coding for top 3
+		if i == 0 {
+			rankLabel.SetText("🥇 " + rankText)
+		} else if i == 1 {
+			rankLabel.SetText("🥈 " + rankText)
+		} else if i == 2 {
+			rankLabel.SetText("🥉 " + rankText)
+		}
+
+		// Create row container
+		row := container.NewHBox(
+			container.NewVBox(rankLabel, dateLabel),
+			container.NewVBox(playerLabel),
+			container.NewVBox(scoreLabel),
+		)
+
+		scoreWidgets = append(scoreWidgets, row)
+		if i < len(highScores)-1 {
+			scoreWidgets = append(scoreWidgets, widget.NewSeparator())
+		}
+	}
+	return scoreWidgets
+}
@@ -0,0 +1,333 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"thats-pretty-clever-scorer/internal/game"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+)
+
+// markEditors open the mark-level editor for each base game area
+var markEditors = map[game.Color]func(gm *GameManager, player *game.Player, window fyne.Window, onChange func()){
+	game.ColorYellow: showYellowMarks,
+	game.ColorGreen:  showGreenMarks,
+	game.ColorOrange: showOrangeMarks,
+	game.ColorPurple: showPurpleMarks,
+	game.ColorBlue:   showBlueMarks,
+}
+
+// createMarksButton creates a small button that opens a mark-level editor for one area
+func createMarksButton(show func()) *widget.Button {
+	btn := widget.NewButton("✏️", show)
+	btn.Importance = widget.LowImportance
+	return btn
+}
+
+// formatRewards describes the rewards unlocked by a mark
+func formatRewards(rewards []game.Reward) string {
+	if len(rewards) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(rewards))
+	for _, reward := range rewards {
+		names = append(names, reward.String())
+	}
+	return "Unlocked: " + strings.Join(names, ", ")
+}
+
+// setCheckedQuietly ticks or clears a check without running its OnChanged handler,
+// so a rejected mark can be undone on screen
+func setCheckedQuietly(check *widget.Check, checked bool) {
+	onChanged := check.OnChanged
+	check.OnChanged = nil
+	check.SetChecked(checked)
+	check.OnChanged = onChanged
+}
+
+// showYellowMarks shows the printed yellow grid so crossed boxes can be ticked off
+func showYellowMarks(gm *GameManager, player *game.Player, window fyne.Window, onChange func()) {
+	yellow := player.ScoreSheet.Yellow
+	totalLabel := widget.NewLabelWithStyle("", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	errorLabel := widget.NewLabel("")
+	errorLabel.Importance = widget.DangerImportance
+	rewardsLabel := widget.NewLabel("")
+
+	updateTotal := func() {
+		totalLabel.SetText(fmt.Sprintf("Yellow total: %d", yellow.Total))
+		onChange()
+	}
+
+	grid := container.NewGridWithColumns(game.YellowSize)
+	for row := range game.YellowSize {
+		for col := range game.YellowSize {
+			if game.YellowPreCrossed(row, col) {
+				preCrossed := widget.NewCheck("✖", nil)
+				preCrossed.SetChecked(true)
+				preCrossed.Disable()
+				grid.Add(preCrossed)
+				continue
+			}
+
+			// Capture row and col to avoid closure issues
+			r, c := row, col
+			check := widget.NewCheck(strconv.Itoa(game.YellowNumber(r, c)), nil)
+			check.SetChecked(yellow.IsMarked(r, c))
+			check.OnChanged = func(marked bool) {
+				var rewards []game.Reward
+				var err error
+				if marked {
+					rewards, err = gm.EditMark(player, "yellow mark", func() ([]game.Reward, error) {
+						return player.ScoreSheet.MarkYellow(r, c)
+					})
+				} else {
+					err = gm.Edit(player, "yellow unmark", func() error {
+						return player.ScoreSheet.UnmarkYellow(r, c)
+					})
+				}
+				if err != nil {
+					// The sheet was left as it was, so the box goes back too
+					setCheckedQuietly(check, !marked)
+					errorLabel.SetText(err.Error())
+					return
+				}
+				errorLabel.SetText("")
+				rewardsLabel.SetText(formatRewards(rewards))
+				updateTotal()
+			}
+			grid.Add(check)
+		}
+	}
+
+	// Column points printed below the grid
+	for col := range game.YellowSize {
+		grid.Add(widget.NewLabelWithStyle(strconv.Itoa(game.YellowColumnPoints(col)), fyne.TextAlignCenter, fyne.TextStyle{Italic: true}))
+	}
+
+	updateTotal()
+
+	content := container.NewVBox(grid, widget.NewSeparator(), errorLabel, rewardsLabel, totalLabel)
+	dialog.ShowCustom("🟡 "+player.Name+" - Yellow", "Done", content, window)
+}
+
+// showBlueMarks shows the blue grid keyed by the sum of the blue and white dice
+func showBlueMarks(gm *GameManager, player *game.Player, window fyne.Window, onChange func()) {
+	blue := player.ScoreSheet.Blue
+	totalLabel := widget.NewLabelWithStyle("", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	errorLabel := widget.NewLabel("")
+	errorLabel.Importance = widget.DangerImportance
+	rewardsLabel := widget.NewLabel("")
+
+	updateTotal := func() {
+		totalLabel.SetText(fmt.Sprintf("Blue total: %d (%d crossed)", blue.Total, blue.Count()))
+		onChange()
+	}
+
+	grid := container.NewGridWithColumns(game.BlueColumns)
+	for row := range game.BlueRows {
+		for col := range game.BlueColumns {
+			sum := game.BlueSum(row, col)
+			if sum == 0 {
+				preCrossed := widget.NewCheck("✖", nil)
+				preCrossed.SetChecked(true)
+				preCrossed.Disable()
+				grid.Add(preCrossed)
+				continue
+			}
+
+			check := widget.NewCheck(strconv.Itoa(sum), nil)
+			check.SetChecked(blue.IsMarked(sum))
+			check.OnChanged = func(marked bool) {
+				var rewards []game.Reward
+				var err error
+				if marked {
+					rewards, err = gm.EditMark(player, "blue mark", func() ([]game.Reward, error) {
+						return player.ScoreSheet.MarkBlue(sum)
+					})
+				} else {
+					err = gm.Edit(player, "blue unmark", func() error {
+						return player.ScoreSheet.UnmarkBlue(sum)
+					})
+				}
+				if err != nil {
+					setCheckedQuietly(check, !marked)
+					errorLabel.SetText(err.Error())
+					return
+				}
+				errorLabel.SetText("")
+				rewardsLabel.SetText(formatRewards(rewards))
+				updateTotal()
+			}
+			grid.Add(check)
+		}
+	}
+
+	updateTotal()
+
+	content := container.NewVBox(
+		widget.NewLabel("Tick the sum of the blue and white dice:"),
+		grid,
+		widget.NewSeparator(),
+		errorLabel,
+		rewardsLabel,
+		totalLabel,
+	)
+	dialog.ShowCustom("🔵 "+player.Name+" - Blue", "Done", content, window)
+}
+
+// trackEditor describes a left-to-right area where die values are entered box by box
+type trackEditor struct {
+	title   string
+	size    int
+	caption func(box int) string // printed requirement or multiplier for an empty box
+	values  func() []int
+	total   func() int
+	mark    func(value int) ([]game.Reward, error)
+	unmark  func() error
+
+	// markFree crosses off the next box without a die, nil when the area has no free crosses
+	markFree func() ([]game.Reward, error)
+}
+
+// showTrackMarks shows a row of boxes with die value buttons to fill the next box
+func showTrackMarks(gm *GameManager, player *game.Player, editor trackEditor, window fyne.Window, onChange func()) {
+	boxes := container.NewGridWithColumns(editor.size)
+	totalLabel := widget.NewLabelWithStyle("", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	errorLabel := widget.NewLabel("")
+	errorLabel.Importance = widget.DangerImportance
+	rewardsLabel := widget.NewLabel("")
+
+	refresh := func() {
+		values := editor.values()
+		boxes.RemoveAll()
+		for box := range editor.size {
+			text := editor.caption(box)
+			if box < len(values) {
+				text = strconv.Itoa(values[box])
+				if values[box] == 0 {
+					text = "✖"
+				}
+			}
+			boxLabel := widget.NewLabelWithStyle(text, fyne.TextAlignCenter, fyne.TextStyle{Bold: box < len(values)})
+			boxes.Add(boxLabel)
+		}
+		boxes.Refresh()
+		totalLabel.SetText(fmt.Sprintf("Total: %d", editor.total()))
+		onChange()
+	}
+
+	diceButtons := container.NewGridWithColumns(6)
+	for value := 1; value <= 6; value++ {
+		// Capture value to avoid closure issues
+		v := value
+		diceButtons.Add(widget.NewButton(strconv.Itoa(v), func() {
+			rewards, err := gm.EditMark(player, editor.title+" mark", func() ([]game.Reward, error) {
+				return editor.mark(v)
+			})
+			if err != nil {
+				errorLabel.SetText(err.Error())
+				return
+			}
+			errorLabel.SetText("")
+			rewardsLabel.SetText(formatRewards(rewards))
+			refresh()
+		}))
+	}
+
+	undoBtn := widget.NewButton("↩️ Remove last", func() {
+		if err := gm.Edit(player, editor.title+" unmark", editor.unmark); err != nil {
+			errorLabel.SetText(err.Error())
+			return
+		}
+		errorLabel.SetText("")
+		rewardsLabel.SetText("")
+		refresh()
+	})
+
+	actions := container.NewHBox(undoBtn)
+	if editor.markFree != nil {
+		freeBtn := widget.NewButton("✖ Free cross", func() {
+			rewards, err := gm.EditMark(player, editor.title+" free cross", editor.markFree)
+			if err != nil {
+				errorLabel.SetText(err.Error())
+				return
+			}
+			errorLabel.SetText("")
+			rewardsLabel.SetText(formatRewards(rewards))
+			refresh()
+		})
+		actions.Add(freeBtn)
+	}
+
+	refresh()
+
+	content := container.NewVBox(
+		boxes,
+		widget.NewSeparator(),
+		widget.NewLabel("Enter die value for the next box:"),
+		diceButtons,
+		actions,
+		errorLabel,
+		rewardsLabel,
+		totalLabel,
+	)
+	dialog.ShowCustom(editor.title, "Done", content, window)
+}
+
+// showGreenMarks shows the green threshold track
+func showGreenMarks(gm *GameManager, player *game.Player, window fyne.Window, onChange func()) {
+	sheet := player.ScoreSheet
+	showTrackMarks(gm, player, trackEditor{
+		title: "🟢 " + player.Name + " - Green",
+		size:  game.GreenSize,
+		caption: func(box int) string {
+			return "≥" + strconv.Itoa(game.GreenThreshold(box))
+		},
+		values: func() []int { return sheet.Green.Values },
+		total:  func() int { return sheet.Green.Total },
+		mark:   sheet.MarkGreen,
+		unmark: sheet.UnmarkGreen,
+		markFree: func() ([]game.Reward, error) {
+			return sheet.ApplyFreeMark(game.Reward{Kind: game.RewardFreeMark, Color: game.ColorGreen})
+		},
+	}, window, onChange)
+}
+
+// showOrangeMarks shows the orange row with its multiplier spaces
+func showOrangeMarks(gm *GameManager, player *game.Player, window fyne.Window, onChange func()) {
+	sheet := player.ScoreSheet
+	showTrackMarks(gm, player, trackEditor{
+		title: "🟠 " + player.Name + " - Orange",
+		size:  game.OrangeSize,
+		caption: func(space int) string {
+			if multiplier := game.OrangeMultiplier(space); multiplier > 1 {
+				return "x" + strconv.Itoa(multiplier)
+			}
+			return "·"
+		},
+		values: func() []int { return sheet.Orange.Values },
+		total:  func() int { return sheet.Orange.Total },
+		mark:   sheet.MarkOrange,
+		unmark: sheet.UnmarkOrange,
+	}, window, onChange)
+}
+
+// showPurpleMarks shows the purple row of increasing values
+func showPurpleMarks(gm *GameManager, player *game.Player, window fyne.Window, onChange func()) {
+	sheet := player.ScoreSheet
+	showTrackMarks(gm, player, trackEditor{
+		title: "🟣 " + player.Name + " - Purple",
+		size:  game.PurpleSize,
+		caption: func(int) string {
+			return "<"
+		},
+		values: func() []int { return sheet.Purple.Values },
+		total:  func() int { return sheet.Purple.Total },
+		mark:   sheet.MarkPurple,
+		unmark: sheet.UnmarkPurple,
+	}, window, onChange)
+}
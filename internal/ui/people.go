@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+	"thats-pretty-clever-scorer/internal/storage"
+)
+
+// CreatePeopleScreen creates a screen listing every player with their aliases,
+// where players can be renamed, duplicate players merged and new aliases added
+func CreatePeopleScreen(db *storage.Database, window fyne.Window) fyne.CanvasObject {
+	list := container.NewVBox()
+
+	var refresh func()
+	refresh = func() {
+		list.Objects = createPeopleRows(db, window, refresh)
+		list.Refresh()
+	}
+	refresh()
+
+	hintLabel := widget.NewLabel("Names are matched without regard to case or extra spaces. " +
+		"Merge players who were saved under different names to combine their history.")
+	hintLabel.Wrapping = fyne.TextWrapWord
+
+	content := container.NewBorder(
+		container.NewVBox(hintLabel, widget.NewSeparator()),
+		nil, nil, nil,
+		container.NewVScroll(list),
+	)
+	return container.NewPadded(content)
+}
+
+// createPeopleRows builds one row per player, calling refresh after a change
+func createPeopleRows(db *storage.Database, window fyne.Window, refresh func()) []fyne.CanvasObject {
+	people, err := db.GetPeople()
+	if err != nil {
+		return []fyne.CanvasObject{widget.NewLabel(fmt.Sprintf("Error loading players: %v", err))}
+	}
+	if len(people) == 0 {
+		return []fyne.CanvasObject{widget.NewLabel("No players yet. Finish a game to see its players here.")}
+	}
+
+	var rows []fyne.CanvasObject
+	for _, person := range people {
+		nameLabel := widget.NewLabelWithStyle(person.Name, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+		details := fmt.Sprintf("%d games", person.Games)
+		if len(person.Aliases) > 0 {
+			details += " · also known as " + strings.Join(person.Aliases, ", ")
+		}
+		detailsLabel := widget.NewLabel(details)
+
+		renameBtn := widget.NewButton("Rename", func() {
+			showRenameDialog(db, person, window, refresh)
+		})
+		aliasBtn := widget.NewButton("Add Alias", func() {
+			showAddAliasDialog(db, person, window, refresh)
+		})
+		mergeBtn := widget.NewButton("Merge Into…", func() {
+			showMergeDialog(db, person, people, window, refresh)
+		})
+		if len(people) < 2 {
+			mergeBtn.Disable()
+		}
+
+		rows = append(rows, container.NewBorder(nil, nil, nil,
+			container.NewHBox(renameBtn, aliasBtn, mergeBtn),
+			container.NewVBox(nameLabel, detailsLabel),
+		), widget.NewSeparator())
+	}
+	return rows
+}
+
+// showAddAliasDialog asks for another name that should refer to a player
+func showAddAliasDialog(db *storage.Database, person *storage.Person, window fyne.Window, refresh func()) {
+	aliasEntry := widget.NewEntry()
+	aliasEntry.SetPlaceHolder("Other name")
+
+	dialog.ShowForm(fmt.Sprintf("Add Alias for %s", person.Name), "Add", "Cancel",
+		[]*widget.FormItem{widget.NewFormItem("Alias", aliasEntry)},
+		func(confirmed bool) {
+			if !confirmed {
+				return
+			}
+			err := db.AddAlias(person.ID, aliasEntry.Text)
+			if errors.Is(err, storage.ErrNameTaken) {
+				dialog.ShowError(fmt.Errorf("%q already belongs to another player; merge the two players instead", storage.NormalizeName(aliasEntry.Text)), window)
+				return
+			}
+			if err != nil {
+				dialog.ShowError(fmt.Errorf("Failed to add alias: %v", err), window)
+				return
+			}
+			refresh()
+		}, window)
+}
+
+// showMergeDialog picks the player to merge into and confirms before moving the history
+func showMergeDialog(db *storage.Database, from *storage.Person, people []*storage.Person, window fyne.Window, refresh func()) {
+	targets := make(map[string]*storage.Person)
+	var options []string
+	for _, person := range people {
+		if person.ID != from.ID {
+			targets[person.Name] = person
+			options = append(options, person.Name)
+		}
+	}
+	targetSelect := widget.NewSelect(options, nil)
+
+	dialog.ShowForm(fmt.Sprintf("Merge %s", from.Name), "Merge", "Cancel",
+		[]*widget.FormItem{widget.NewFormItem("Merge into", targetSelect)},
+		func(confirmed bool) {
+			into, ok := targets[targetSelect.Selected]
+			if !confirmed || !ok {
+				return
+			}
+
+			message := fmt.Sprintf("Move %d games of %s to %s?\n\n%s will become an alias of %s.",
+				from.Games, from.Name, into.Name, from.Name, into.Name)
+			dialog.ShowConfirm("Merge Players", message, func(confirmed bool) {
+				if !confirmed {
+					return
+				}
+				if err := db.MergePeople(from.ID, into.ID); err != nil {
+					dialog.ShowError(fmt.Errorf("Failed to merge players: %v", err), window)
+					return
+				}
+				refresh()
+			}, window)
+		}, window)
+}
+
+// showRenameDialog asks for a player's new name and previews the change before renaming
+func showRenameDialog(db *storage.Database, person *storage.Person, window fyne.Window, refresh func()) {
+	nameEntry := widget.NewEntry()
+	nameEntry.SetText(person.Name)
+
+	dialog.ShowForm(fmt.Sprintf("Rename %s", person.Name), "Preview", "Cancel",
+		[]*widget.FormItem{widget.NewFormItem("New name", nameEntry)},
+		func(confirmed bool) {
+			if !confirmed {
+				return
+			}
+			preview, err := db.PreviewRename(person.ID, nameEntry.Text)
+			if errors.Is(err, storage.ErrNameTaken) {
+				dialog.ShowError(fmt.Errorf("%q already belongs to another player; merge the two players instead", storage.NormalizeName(nameEntry.Text)), window)
+				return
+			}
+			if err != nil {
+				dialog.ShowError(fmt.Errorf("Failed to preview rename: %v", err), window)
+				return
+			}
+			if preview.NewName == person.Name && len(preview.OldNames) == 0 {
+				return
+			}
+			showRenamePreview(db, preview, window, refresh)
+		}, window)
+}
+
+// showRenamePreview lists the games and high scores a rename touches and renames on confirmation
+func showRenamePreview(db *storage.Database, preview *storage.RenamePreview, window fyne.Window, refresh func()) {
+	summary := fmt.Sprintf("Rename %s to %s in %d games and %d high scores?",
+		preview.Person.Name, preview.NewName, len(preview.Games), len(preview.HighScores))
+	if len(preview.OldNames) > 0 {
+		summary += fmt.Sprintf("\nSaved as: %s", strings.Join(preview.OldNames, ", "))
+	}
+	summaryLabel := widget.NewLabel(summary)
+	summaryLabel.Wrapping = fyne.TextWrapWord
+
+	rows := []fyne.CanvasObject{
+		widget.NewLabelWithStyle("Games", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+	}
+	for _, game := range preview.Games {
+		rows = append(rows, widget.NewLabel(fmt.Sprintf("%s · %d players · won by %s with %d",
+			game.CreatedAt.Format("Jan 2, 2006"), game.PlayerCount, game.WinnerName, game.WinnerScore)))
+	}
+	rows = append(rows, widget.NewLabelWithStyle("High Scores", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}))
+	if len(preview.HighScores) == 0 {
+		rows = append(rows, widget.NewLabel("None"))
+	}
+	for _, hs := range preview.HighScores {
+		rows = append(rows, widget.NewLabel(fmt.Sprintf("%d points as %s · %s",
+			hs.Score, hs.PlayerName, hs.AchievedAt.Format("Jan 2, 2006"))))
+	}
+
+	scroll := container.NewVScroll(container.NewVBox(rows...))
+	scroll.SetMinSize(fyne.NewSize(400, 250))
+	content := container.NewBorder(summaryLabel, nil, nil, nil, scroll)
+
+	dialog.ShowCustomConfirm("Rename Player", "Rename", "Cancel", content, func(confirmed bool) {
+		if !confirmed {
+			return
+		}
+		if err := db.RenamePerson(preview.Person.ID, preview.NewName); err != nil {
+			dialog.ShowError(fmt.Errorf("Failed to rename player: %v", err), window)
+			return
+		}
+		refresh()
+	}, window)
+}
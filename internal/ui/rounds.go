@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"thats-pretty-clever-scorer/internal/game"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// CreateRoundBar creates the round tracker bar shown above the score calculator.
+// onGameEnd is called when the last turn of the last round has been played.
+func CreateRoundBar(gm *GameManager, onGameEnd func()) fyne.CanvasObject {
+	roundLabel := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+	rewardsLabel := widget.NewLabelWithStyle("", fyne.TextAlignLeading, fyne.TextStyle{Italic: true})
+	errorLabel := widget.NewLabel("")
+	errorLabel.Importance = widget.DangerImportance
+	botLabel := widget.NewLabel("")
+	botLabel.Wrapping = fyne.TextWrapWord
+
+	// showRoundRewards announces the rewards every player receives at the start of a round
+	showRoundRewards := func(round int) {
+		rewards := game.RoundRewards(round)
+		if len(rewards) == 0 {
+			rewardsLabel.SetText("")
+			return
+		}
+		names := make([]string, 0, len(rewards))
+		for _, reward := range rewards {
+			names = append(names, reward.String())
+		}
+		rewardsLabel.SetText(fmt.Sprintf("Round %d reward for everyone: %s", round, strings.Join(names, ", ")))
+	}
+
+	// The callbacks are set before round 1 starts, so its rewards are announced as well
+	onRoundStart := func(round int, _ []game.Reward) {
+		showRoundRewards(round)
+	}
+	if err := gm.StartRounds(onRoundStart, onGameEnd); err != nil {
+		return widget.NewLabel("Add players to track rounds")
+	}
+	rounds := gm.Rounds
+	// A tracker kept from before, like a resumed game, is already in its round
+	showRoundRewards(rounds.Round)
+
+	var nextTurnBtn *widget.Button
+	updateRound := func() {
+		botLabel.SetText(strings.Join(gm.TurnLog, "\n"))
+		if rounds.Finished {
+			roundLabel.SetText(fmt.Sprintf("🏁 Game over after %d rounds", rounds.TotalRounds))
+			nextTurnBtn.Disable()
+			return
+		}
+		roundLabel.SetText(fmt.Sprintf("Round %d of %d · 🎲 Active: %s",
+			rounds.Round, rounds.TotalRounds, rounds.ActivePlayer().Name))
+	}
+
+	nextTurnBtn = widget.NewButton("Next Turn ▶", func() {
+		if err := gm.AdvanceTurn(); err != nil {
+			errorLabel.SetText(fmt.Sprintf("Cannot move on to the next turn: %v", err))
+		} else {
+			errorLabel.SetText("")
+		}
+		updateRound()
+		gm.NotifySheetsChanged()
+	})
+	nextTurnBtn.Importance = widget.MediumImportance
+
+	updateRound()
+
+	return container.NewVBox(
+		container.NewBorder(nil, nil, nil, nextTurnBtn, roundLabel),
+		rewardsLabel,
+		errorLabel,
+		botLabel,
+	)
+}
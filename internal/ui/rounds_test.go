@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"slices"
+	"testing"
+
+	"thats-pretty-clever-scorer/internal/game"
+)
+
+func TestStartRoundsReportsRoundOne(t *testing.T) {
+	gm := NewGameManager()
+	gm.AddPlayer("Alice")
+	gm.AddPlayer("Bob")
+
+	var started []int
+	onRoundStart := func(round int, _ []game.Reward) { started = append(started, round) }
+	if err := gm.StartRounds(onRoundStart, nil); err != nil {
+		t.Fatalf("StartRounds: %v", err)
+	}
+	if !slices.Equal(started, []int{1}) {
+		t.Errorf("rounds started = %v, want round 1 reported", started)
+	}
+
+	// Coming back to the calculator keeps the game and does not start round 1 again
+	ended := false
+	if err := gm.StartRounds(onRoundStart, func() { ended = true }); err != nil {
+		t.Fatalf("StartRounds: %v", err)
+	}
+	if !slices.Equal(started, []int{1}) {
+		t.Errorf("rounds started = %v after starting again, want only round 1", started)
+	}
+	for !gm.Rounds.Finished {
+		if err := gm.AdvanceTurn(); err != nil {
+			t.Fatalf("AdvanceTurn: %v", err)
+		}
+	}
+	if !ended || len(started) != gm.Rounds.TotalRounds {
+		t.Errorf("game ended %v after %d round starts, want the new callbacks to see all %d rounds", ended, len(started), gm.Rounds.TotalRounds)
+	}
+	if err := gm.AdvanceTurn(); err == nil {
+		t.Error("AdvanceTurn after the last round returned no error")
+	}
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"fmt"
 	"slices"
 	"strconv"
 	"sync"
@@ -51,45 +52,209 @@ func (ic *iconCache) getIcon(resource fyne.Resource) fyne.CanvasObject {
 }
 
 type GameManager struct {
+	Ruleset game.Ruleset
 	Players []*game.Player
+	Rounds  *game.RoundTracker
+	History *game.History
+	Roller  game.Roller // dice rolled by bots
+
+	// TurnLog describes the dice rolled and the bot moves of the last turn change
+	TurnLog []string
+
+	// sheetListeners refresh the player cards when sheets change outside of their own entries
+	sheetListeners []func()
+
+	// autosave checkpoints the game in progress after every change, nil when not saving
+	autosave func(state *game.GameState) error
 }
 
 func NewGameManager() *GameManager {
 	return &GameManager{
+		Ruleset: game.DefaultRuleset(),
 		Players: make([]*game.Player, 0),
+		History: game.NewHistory(),
+		Roller:  newRoller(),
 	}
 }
 
 func (gm *GameManager) AddPlayer(name string) {
-	player := game.NewPlayer(name)
+	player := game.NewPlayerWithRuleset(name, gm.Ruleset)
 	gm.Players = append(gm.Players, player)
 }
 
+// SetRuleset switches the edition being played. Existing players get fresh sheets
+// for the new edition, since areas cannot be carried across editions. Bots are dropped
+// from editions they cannot play.
+func (gm *GameManager) SetRuleset(ruleset game.Ruleset) {
+	if gm.Ruleset.ID() == ruleset.ID() {
+		return
+	}
+	gm.Ruleset = ruleset
+	players := gm.Players[:0]
+	for _, player := range gm.Players {
+		if player.IsBot() && !ruleset.MarkLevel() {
+			continue
+		}
+		replacement := game.NewPlayerWithRuleset(player.Name, ruleset)
+		replacement.Bot = player.Bot
+		players = append(players, replacement)
+	}
+	gm.Players = players
+	gm.Rounds = nil
+	gm.History = game.NewHistory()
+}
+
 func (gm *GameManager) RemovePlayer(index int) {
 	if index >= 0 && index < len(gm.Players) {
 		gm.Players = slices.Delete(gm.Players, index, index+1)
 	}
 }
 
+// StartRounds starts the round tracker for the current players. The callbacks are set before
+// round 1 begins, so its rewards are reported too. An existing tracker is kept unless the
+// players have changed since it was started, and only gets the new callbacks.
+func (gm *GameManager) StartRounds(onRoundStart func(round int, pending []game.Reward), onGameEnd func()) error {
+	if gm.Rounds != nil && slices.Equal(gm.Rounds.Players, gm.Players) {
+		gm.Rounds.OnRoundStart = onRoundStart
+		gm.Rounds.OnGameEnd = onGameEnd
+		return nil
+	}
+
+	rounds, err := game.NewRoundTracker(gm.Players)
+	if err != nil {
+		return err
+	}
+	rounds.OnRoundStart = onRoundStart
+	rounds.OnGameEnd = onGameEnd
+	gm.Rounds = rounds
+	gm.Rounds.Start()
+	gm.TurnLog = nil
+	gm.playBotTurn()
+	gm.checkpoint()
+	return nil
+}
+
+// OnSheetsChanged registers a callback run by NotifySheetsChanged
+func (gm *GameManager) OnSheetsChanged(listener func()) {
+	gm.sheetListeners = append(gm.sheetListeners, listener)
+}
+
+// NotifySheetsChanged refreshes every registered player card
+func (gm *GameManager) NotifySheetsChanged() {
+	for _, listener := range gm.sheetListeners {
+		listener()
+	}
+}
+
+// Edit applies a change to a player's sheet and records it in the undo history
+func (gm *GameManager) Edit(player *game.Player, description string, mutate func() error) error {
+	if err := gm.History.Record(player, description, mutate); err != nil {
+		return err
+	}
+	gm.checkpoint()
+	return nil
+}
+
+// EditMark records a mark in the undo history and returns the rewards it unlocked
+func (gm *GameManager) EditMark(player *game.Player, description string, mark func() ([]game.Reward, error)) ([]game.Reward, error) {
+	var rewards []game.Reward
+	err := gm.Edit(player, description, func() error {
+		var err error
+		rewards, err = mark()
+		return err
+	})
+	return rewards, err
+}
+
+// Undo reverts the most recent sheet change and refreshes the player cards
+func (gm *GameManager) Undo() error {
+	if _, err := gm.History.Undo(); err != nil {
+		return err
+	}
+	gm.NotifySheetsChanged()
+	gm.checkpoint()
+	return nil
+}
+
+// Redo reapplies the most recently undone sheet change and refreshes the player cards
+func (gm *GameManager) Redo() error {
+	if _, err := gm.History.Redo(); err != nil {
+		return err
+	}
+	gm.NotifySheetsChanged()
+	gm.checkpoint()
+	return nil
+}
+
 func (gm *GameManager) UpdatePlayerName(index int, newName string) {
 	if index >= 0 && index < len(gm.Players) {
 		gm.Players[index].Name = newName
 	}
 }
 
-func updateTotalsFunc(sa game.ScoreArea, player *game.Player, updateDisplays func()) func(string) {
+func updateTotalsFunc(sa game.ScoreArea, current func() int, description string, player *game.Player, gm *GameManager, updateDisplays func()) func(string) {
 	return func(value string) {
 		// If value is unset, set it to 0
 		if value == "" {
 			value = "0"
 		}
 
-		if num, err := strconv.Atoi(value); err == nil && num >= 0 {
+		num, err := strconv.Atoi(value)
+		if err != nil || num < 0 || num == current() {
+			// Entries synced from the sheet land here, so they are not logged as edits
+			return
+		}
+
+		gm.History.RecordEdit(player, description, func() error {
 			sa.Record(num)
 			player.ScoreSheet.CalculateBonus()
+			return nil
+		})
+		gm.checkpoint()
+		updateDisplays()
+	}
+}
+
+// syncEntry shows a sheet value in an entry unless the entry already holds it
+func syncEntry(entry *cWidget.NumericalEntry, value int) {
+	current, err := strconv.Atoi(entry.Text)
+	if entry.Text == "" {
+		current, err = 0, nil
+	}
+	if err != nil || current != value {
+		entry.SetText(strconv.Itoa(value))
+	}
+}
+
+// formatActionTrack shows how many actions are left out of those earned
+func formatActionTrack(track *game.ActionTrack) string {
+	return fmt.Sprintf("%d left (%d earned)", track.Available(), track.Earned())
+}
+
+// createActionTrackRow creates a row to earn and spend reroll or +1 actions
+func createActionTrackRow(symbol string, track func() *game.ActionTrack, label *widget.Label, edit func(string, func() error) error, updateDisplays func()) fyne.CanvasObject {
+	useBtn := widget.NewButton("Use", func() {
+		if edit(symbol+" used", func() error { return track().Use() }) == nil {
 			updateDisplays()
 		}
-	}
+	})
+	refundBtn := widget.NewButton("↩️", func() {
+		if edit(symbol+" refunded", func() error { return track().Refund() }) == nil {
+			updateDisplays()
+		}
+	})
+	refundBtn.Importance = widget.LowImportance
+	grantBtn := widget.NewButton("+", func() {
+		edit(symbol+" earned", func() error {
+			track().Grant()
+			return nil
+		})
+		updateDisplays()
+	})
+	grantBtn.Importance = widget.LowImportance
+
+	symbolLabel := widget.NewLabelWithStyle(symbol, fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+	return container.NewGridWithColumns(2, symbolLabel, container.NewBorder(nil, nil, nil, container.NewHBox(useBtn, refundBtn, grantBtn), label))
 }
 
 // createIcon creates a cached icon for better performance
@@ -97,22 +262,40 @@ func createIcon(iconResource fyne.Resource) fyne.CanvasObject {
 	return globalIconCache.getIcon(iconResource)
 }
 
-func CreatePlayerScoreUI(player *game.Player, index int, gm *GameManager) fyne.CanvasObject {
-	// Create section inputs
-	yellowEntry := cWidget.NewNumericalEntry()
-	yellowEntry.SetPlaceHolder("0")
+// areaIcons are the bundled icons for the base game areas
+var areaIcons = map[game.Color]fyne.Resource{
+	game.ColorYellow: assets.ResourceYellowSvg,
+	game.ColorGreen:  assets.ResourceGreenSvg,
+	game.ColorOrange: assets.ResourceOrangeSvg,
+	game.ColorPurple: assets.ResourcePurpleSvg,
+	game.ColorBlue:   assets.ResourceBlueSvg,
+}
 
-	greenEntry := cWidget.NewNumericalEntry()
-	greenEntry.SetPlaceHolder("0")
+// areaSymbols label areas that have no bundled icon
+var areaSymbols = map[game.Color]string{
+	game.ColorSilver: "⚪",
+	game.ColorPink:   "🩷",
+}
 
-	orangeEntry := cWidget.NewNumericalEntry()
-	orangeEntry.SetPlaceHolder("0")
+// createAreaIcon returns the icon for an area, or a labelled symbol when there is none
+func createAreaIcon(area game.Color) fyne.CanvasObject {
+	if icon, ok := areaIcons[area]; ok {
+		return createIcon(icon)
+	}
+	return widget.NewLabelWithStyle(areaSymbols[area]+" "+game.AreaName(area), fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
+}
 
-	purpleEntry := cWidget.NewNumericalEntry()
-	purpleEntry.SetPlaceHolder("0")
+func CreatePlayerScoreUI(player *game.Player, index int, gm *GameManager, window fyne.Window) fyne.CanvasObject {
+	sheet := player.ScoreSheet
+	areas := sheet.Ruleset.Areas()
 
-	blueEntry := cWidget.NewNumericalEntry()
-	blueEntry.SetPlaceHolder("0")
+	// Create section inputs, one per area of the edition being played
+	entries := make(map[game.Color]*cWidget.NumericalEntry, len(areas))
+	for _, area := range areas {
+		entry := cWidget.NewNumericalEntry()
+		entry.SetPlaceHolder("0")
+		entries[area] = entry
+	}
 
 	foxEntry := cWidget.NewNumericalEntry()
 	foxEntry.SetPlaceHolder("0")
@@ -121,46 +304,92 @@ func CreatePlayerScoreUI(player *game.Player, index int, gm *GameManager) fyne.C
 	totalLabel := widget.NewLabelWithStyle("0", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 	bonusLabel := widget.NewLabelWithStyle("0", fyne.TextAlignCenter, fyne.TextStyle{Bold: true})
 
+	// Reroll and +1 action tracks
+	rerollLabel := widget.NewLabel("")
+	plusOneLabel := widget.NewLabel("")
+
 	updateDisplays := func() {
 		totalLabel.SetText(strconv.Itoa(player.GetTotalScore()))
-		bonusLabel.SetText(strconv.Itoa(player.ScoreSheet.Bonus.Total))
+		bonusLabel.SetText(strconv.Itoa(sheet.Bonus.Total))
+		rerollLabel.SetText(formatActionTrack(sheet.Rerolls))
+		plusOneLabel.SetText(formatActionTrack(sheet.PlusOnes))
+	}
+
+	edit := func(description string, mutate func() error) error {
+		return gm.Edit(player, description, mutate)
 	}
+	rerollRow := createActionTrackRow("🔄", func() *game.ActionTrack { return sheet.Rerolls }, rerollLabel, edit, updateDisplays)
+	plusOneRow := createActionTrackRow("➕1", func() *game.ActionTrack { return sheet.PlusOnes }, plusOneLabel, edit, updateDisplays)
 
 	// Update displays when any entry changes
-	yellowEntry.OnChanged = updateTotalsFunc(player.ScoreSheet.Yellow, player, updateDisplays)
-	greenEntry.OnChanged = updateTotalsFunc(player.ScoreSheet.Green, player, updateDisplays)
-	orangeEntry.OnChanged = updateTotalsFunc(player.ScoreSheet.Orange, player, updateDisplays)
-	purpleEntry.OnChanged = updateTotalsFunc(player.ScoreSheet.Purple, player, updateDisplays)
-	blueEntry.OnChanged = updateTotalsFunc(player.ScoreSheet.Blue, player, updateDisplays)
-	foxEntry.OnChanged = updateTotalsFunc(player.ScoreSheet.Bonus, player, updateDisplays)
+	for _, area := range areas {
+		entries[area].OnChanged = updateTotalsFunc(sheet.Area(area), func() int { return sheet.AreaTotal(area) }, string(area)+" total", player, gm, updateDisplays)
+	}
+	foxEntry.OnChanged = updateTotalsFunc(sheet.Bonus, func() int { return sheet.Bonus.FoxCount }, "fox count", player, gm, updateDisplays)
+
+	// lockFoxEntry makes the fox entry read-only once boxes are ticked, as the foxes then come from the marks
+	lockFoxEntry := func() {
+		if sheet.HasMarks() {
+			foxEntry.Disable()
+		} else {
+			foxEntry.Enable()
+		}
+	}
+
+	// syncEntries shows the sheet totals after marks, undo or redo changed them
+	syncEntries := func() {
+		for _, area := range areas {
+			syncEntry(entries[area], sheet.AreaTotal(area))
+		}
+		syncEntry(foxEntry, sheet.Bonus.FoxCount)
+		lockFoxEntry()
+		updateDisplays()
+	}
 
 	// Initial update
+	lockFoxEntry()
 	updateDisplays()
+	gm.OnSheetsChanged(syncEntries)
 
 	// Simplified layout for better performance
 	// Use single container with inline arrangement instead of nested grids
 	playerCard := container.NewVBox(
 		widget.NewLabelWithStyle("👤 "+player.Name, fyne.TextAlignCenter, fyne.TextStyle{Bold: true}),
 		widget.NewSeparator(),
-		// Main scoring sections - flattened layout
-		container.NewGridWithColumns(2, createIcon(assets.ResourceYellowSvg), yellowEntry),
-		container.NewGridWithColumns(2, createIcon(assets.ResourceGreenSvg), greenEntry),
-		container.NewGridWithColumns(2, createIcon(assets.ResourceOrangeSvg), orangeEntry),
-		container.NewGridWithColumns(2, createIcon(assets.ResourcePurpleSvg), purpleEntry),
-		container.NewGridWithColumns(2, createIcon(assets.ResourceBlueSvg), blueEntry),
-		widget.NewSeparator(),
-		// Bonus section
-		container.NewGridWithColumns(2, createIcon(assets.ResourceFoxSvg), foxEntry),
-		container.NewGridWithColumns(2, createIcon(assets.ResourceStarSvg), bonusLabel),
-		widget.NewSeparator(),
-		// Total section
-		container.NewGridWithColumns(2, createIcon(assets.ResourceTargetSvg), totalLabel),
 	)
 
+	// Main scoring sections - flattened layout
+	for _, area := range areas {
+		var field fyne.CanvasObject = entries[area]
+
+		// Mark-level editors keep the typed totals in sync with the crossed boxes.
+		// Foxes are derived from the marks, so the fox entry is refreshed as well.
+		if showMarks, ok := markEditors[area]; ok && sheet.Ruleset.MarkLevel() {
+			marksBtn := createMarksButton(func() {
+				showMarks(gm, player, window, syncEntries)
+			})
+			field = container.NewBorder(nil, nil, nil, marksBtn, entries[area])
+		}
+
+		playerCard.Add(container.NewGridWithColumns(2, createAreaIcon(area), field))
+	}
+
+	playerCard.Add(widget.NewSeparator())
+	// Bonus section
+	playerCard.Add(container.NewGridWithColumns(2, createIcon(assets.ResourceFoxSvg), foxEntry))
+	playerCard.Add(container.NewGridWithColumns(2, createIcon(assets.ResourceStarSvg), bonusLabel))
+	playerCard.Add(widget.NewSeparator())
+	// Action tracks
+	playerCard.Add(rerollRow)
+	playerCard.Add(plusOneRow)
+	playerCard.Add(widget.NewSeparator())
+	// Total section
+	playerCard.Add(container.NewGridWithColumns(2, createIcon(assets.ResourceTargetSvg), totalLabel))
+
 	return playerCard
 }
 
-func CreateAllPlayersUI(gm *GameManager) fyne.CanvasObject {
+func CreateAllPlayersUI(gm *GameManager, window fyne.Window) fyne.CanvasObject {
 	// Create grid layout for better screen utilization
 	grid := container.NewGridWithColumns(2) // 2 columns of players
 	grid.Refresh()
@@ -168,10 +397,11 @@ func CreateAllPlayersUI(gm *GameManager) fyne.CanvasObject {
 	refreshPlayers := func() {
 		// Clear existing content
 		grid.Objects = nil
+		gm.sheetListeners = nil
 
 		// Add players to grid (up to 2 per row)
 		for i, player := range gm.Players {
-			playerUI := CreatePlayerScoreUI(player, i, gm)
+			playerUI := CreatePlayerScoreUI(player, i, gm, window)
 			grid.Add(playerUI)
 		}
 		grid.Refresh()
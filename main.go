@@ -2,7 +2,10 @@ package main
 
 import (
 	"fmt"
+	"log/slog"
+	"slices"
 	"strconv"
+	"thats-pretty-clever-scorer/internal/game"
 	"thats-pretty-clever-scorer/internal/storage"
 	"thats-pretty-clever-scorer/internal/ui"
 	"time"
@@ -18,6 +21,19 @@ import (
 // Global navigation container reference
 var globalNav *container.Navigation
 
+// screenCleanups run when their screen is taken off the navigation stack
+var screenCleanups = make(map[fyne.CanvasObject]func())
+
+// navigateBack goes back one screen and cleans up after the screen that was left
+func navigateBack() fyne.CanvasObject {
+	left := globalNav.Back()
+	if cleanup, ok := screenCleanups[left]; ok {
+		delete(screenCleanups, left)
+		cleanup()
+	}
+	return left
+}
+
 func main() {
 	myApp := app.NewWithID("com.squ1d123.ganzcleverscorer")
 	myApp.SetIcon(nil)
@@ -41,7 +57,7 @@ func main() {
 			if ev.Name == mobile.KeyBack {
 				// globalNav should be initialized at this point from setupNavigation
 				if globalNav != nil {
-					globalNav.Back()
+					navigateBack()
 				}
 			}
 		})
@@ -58,9 +74,16 @@ func setupNavigation(app fyne.App, window fyne.Window, db *storage.Database) {
 
 	// Initialize navigation container with main menu as root
 	globalNav = container.NewNavigationWithTitle(mainMenu, "Ganz Schön Clever Scorer")
+	// The title bar's back button cleans up after the screen it leaves, like every other way back
+	globalNav.OnBack = func() { navigateBack() }
 
 	// Set navigation container as window content
 	window.SetContent(globalNav)
+
+	// Offer to carry on with a game that was interrupted, for example when the app was killed
+	ui.ShowResumeDialog(db, window, func(gm *ui.GameManager) {
+		showScoreCalculator(app, window, gm, db)
+	})
 }
 
 // navigateToScreen handles navigation to different screens using the navigation container
@@ -74,24 +97,37 @@ func navigateToScreen(app fyne.App, window fyne.Window, db *storage.Database, sc
 	case "setup":
 		setupScreen := createSetupScreen(app, window, db)
 		globalNav.PushWithTitle(setupScreen, "🎮 Game Setup")
+	case "resume":
+		draft, err := db.LoadDraft()
+		if err != nil {
+			dialog.ShowError(fmt.Errorf("Failed to load game in progress: %v", err), window)
+			return
+		}
+		if draft == nil {
+			dialog.ShowInformation("No Game in Progress", "There is no unfinished game to resume.", window)
+			return
+		}
+		showScoreCalculator(app, window, ui.NewGameManagerFromState(draft.State), db)
 	case "history":
 		historyScreen := ui.CreateGameHistoryScreen(db, func(gameID string) {
 			detailsScreen := ui.CreateGameDetailsScreen(db, gameID, func() {
-				globalNav.Back() // Go back to history
+				navigateBack() // Go back to history
 			}, window)
 			globalNav.PushWithTitle(detailsScreen, "📊 Game Details")
 		}, func() {
-			globalNav.Back() // Go back to main menu
+			navigateBack() // Go back to main menu
 		})
 		globalNav.PushWithTitle(historyScreen, "📊 Game History")
 	case "highscores":
 		highScoresScreen := ui.CreateHighScoresScreen(db, func() {
-			globalNav.Back() // Go back to main menu
+			navigateBack() // Go back to main menu
 		})
 		globalNav.PushWithTitle(highScoresScreen, "🏅 High Scores")
+	case "people":
+		globalNav.PushWithTitle(ui.CreatePeopleScreen(db, window), "👥 Players")
 	case "cleanup":
 		cleanupScreen := ui.CreateCleanupScreen(db, func() {
-			globalNav.Back() // Go back to main menu
+			navigateBack() // Go back to main menu
 		}, window)
 		globalNav.PushWithTitle(cleanupScreen, "🧹 Manage Data")
 	}
@@ -207,9 +243,53 @@ func createSetupScreen(app fyne.App, window fyne.Window, db *storage.Database) f
 	})
 	addPlayerBtn.Importance = widget.MediumImportance
 
+	// Computer opponents, played with one of the built-in strategies
+	strategyNames := map[string]string{}
+	strategyOptions := make([]string, 0, len(game.StrategyIDs()))
+	for _, id := range game.StrategyIDs() {
+		strategy, err := game.NewStrategy(id, gm.Roller)
+		if err != nil {
+			continue
+		}
+		strategyNames[strategy.Name()] = id
+		strategyOptions = append(strategyOptions, strategy.Name())
+	}
+	strategySelect := widget.NewSelect(strategyOptions, nil)
+	strategySelect.SetSelectedIndex(0)
+
+	addBotBtn := widget.NewButton("🤖 Add Bot", func() {
+		if err := gm.AddBot(strategyNames[strategySelect.Selected]); err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		updatePlayerList()
+	})
+
+	// Edition selection, stored with the saved game
+	rulesetNames := make([]string, 0, len(game.Rulesets()))
+	for _, ruleset := range game.Rulesets() {
+		rulesetNames = append(rulesetNames, ruleset.Name())
+	}
+	rulesetSelect := widget.NewSelect(rulesetNames, func(name string) {
+		for _, ruleset := range game.Rulesets() {
+			if ruleset.Name() == name {
+				gm.SetRuleset(ruleset)
+			}
+		}
+		updatePlayerList()
+		if gm.Ruleset.MarkLevel() {
+			addBotBtn.Enable()
+		} else {
+			addBotBtn.Disable()
+		}
+	})
+	rulesetSelect.SetSelected(gm.Ruleset.Name())
+
 	startCalculatorBtn := widget.NewButton("Open Score Calculator", func() {
 		if len(gm.Players) > 0 {
-			showScoreCalculator(app, window, gm, db)
+			ui.ConfirmReplaceDraft(db, gm, window, func() {
+				showScoreCalculator(app, window, gm, db)
+			})
 		}
 	})
 	startCalculatorBtn.Importance = widget.HighImportance
@@ -222,6 +302,9 @@ func createSetupScreen(app fyne.App, window fyne.Window, db *storage.Database) f
 		container.NewVBox(
 			subtitleLabel,
 			widget.NewSeparator(),
+			widget.NewLabelWithStyle("🎲 Edition:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
+			rulesetSelect,
+			widget.NewSeparator(),
 			widget.NewLabelWithStyle("👥 Add Players (1-4 players):", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 			searchEntry,
 			widget.NewLabelWithStyle("Quick Add:", fyne.TextAlignLeading, fyne.TextStyle{Italic: true}),
@@ -232,6 +315,8 @@ func createSetupScreen(app fyne.App, window fyne.Window, db *storage.Database) f
 				playerEntry,
 				addPlayerBtn,
 			),
+			widget.NewLabelWithStyle("Or Add a Computer Opponent:", fyne.TextAlignLeading, fyne.TextStyle{Italic: true}),
+			container.NewBorder(nil, nil, nil, addBotBtn, strategySelect),
 			widget.NewSeparator(),
 			widget.NewLabelWithStyle("📋 Current Players:", fyne.TextAlignLeading, fyne.TextStyle{Bold: true}),
 		),
@@ -246,13 +331,44 @@ func createSetupScreen(app fyne.App, window fyne.Window, db *storage.Database) f
 }
 
 func showScoreCalculator(app fyne.App, window fyne.Window, gm *ui.GameManager, db *storage.Database) {
-	calculatorUI := ui.CreateAllPlayersUI(gm)
+	// Checkpoint every change so the game survives the app being closed
+	gm.SetAutosave(db.SaveDraft)
+
+	// Move on to the final scores automatically once the last round is over
+	roundBar := ui.CreateRoundBar(gm, func() {
+		finalScoresScreen := createFinalScoresScreen(app, window, gm, db)
+		globalNav.PushWithTitle(finalScoresScreen, "🏆 Final Scores")
+	})
+
+	calculatorUI := ui.CreateAllPlayersUI(gm, window)
+	advisorPanel := ui.CreateAdvisorPanel(gm)
 
-	backBtn := widget.NewButton("Back to Setup", func() {
-		globalNav.Back() // Go back to setup screen
+	backBtn := widget.NewButton("← Back", func() {
+		navigateBack() // Go back to setup screen, or the main menu for a resumed game
 	})
 	backBtn.Importance = widget.MediumImportance
 
+	undo := func() {
+		if err := gm.Undo(); err != nil {
+			dialog.ShowError(fmt.Errorf("Cannot undo: %v", err), window)
+		}
+	}
+	redo := func() {
+		if err := gm.Redo(); err != nil {
+			dialog.ShowError(fmt.Errorf("Cannot redo: %v", err), window)
+		}
+	}
+	undoBtn := widget.NewButton("↩️ Undo", undo)
+	redoBtn := widget.NewButton("↪️ Redo", redo)
+
+	// Desktop keyboard shortcuts for undo and redo, removed again once the calculator is left
+	window.Canvas().AddShortcut(&fyne.ShortcutUndo{}, func(fyne.Shortcut) {
+		undo()
+	})
+	window.Canvas().AddShortcut(&fyne.ShortcutRedo{}, func(fyne.Shortcut) {
+		redo()
+	})
+
 	finishBtn := widget.NewButton("Show Final Scores", func() {
 		finalScoresScreen := createFinalScoresScreen(app, window, gm, db)
 		globalNav.PushWithTitle(finalScoresScreen, "🏆 Final Scores")
@@ -261,13 +377,21 @@ func showScoreCalculator(app fyne.App, window fyne.Window, gm *ui.GameManager, d
 
 	// Create content (navigation bar will be handled by Navigation container)
 	content := container.NewVBox(
+		roundBar,
+		widget.NewSeparator(),
 		calculatorUI,
 		widget.NewSeparator(),
-		container.NewHBox(backBtn, finishBtn),
+		advisorPanel,
+		widget.NewSeparator(),
+		container.NewHBox(backBtn, undoBtn, redoBtn, finishBtn),
 	)
 
 	// Push this screen to navigation
 	calculatorScreen := container.NewPadded(container.NewScroll(content))
+	screenCleanups[calculatorScreen] = func() {
+		window.Canvas().RemoveShortcut(&fyne.ShortcutUndo{})
+		window.Canvas().RemoveShortcut(&fyne.ShortcutRedo{})
+	}
 	globalNav.PushWithTitle(calculatorScreen, "📊 Score Calculator")
 }
 
@@ -280,22 +404,37 @@ func createFinalScoresScreen(app fyne.App, window fyne.Window, gm *ui.GameManage
 		widget.NewSeparator(),
 	)
 
-	// Find winner
-	maxScore := -1
-	for _, player := range gm.Players {
-		if player.GetTotalScore() > maxScore {
-			maxScore = player.GetTotalScore()
+	// A solo game has no winner, the score is rated instead
+	if game.IsSolo(gm.Players) {
+		player := gm.Players[0]
+		score := player.GetTotalScore()
+		scoreLabel := widget.NewLabelWithStyle("🎯 "+player.Name+": "+strconv.Itoa(score)+" points", fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+		scoreLabel.Importance = widget.HighImportance
+		content.Add(scoreLabel)
+		if rating := game.RateSolo(gm.Ruleset, score); rating != "" {
+			content.Add(widget.NewLabelWithStyle("Solo rating: "+rating, fyne.TextAlignLeading, fyne.TextStyle{Italic: true}))
 		}
+		content.Add(widget.NewSeparator())
+	}
+
+	// Resolve the winners, ties go to the highest single area and may end in a shared win
+	winners := game.Winners(gm.Players)
+	winnerTag := " (WINNER): "
+	if len(winners) > 1 {
+		winnerTag = " (SHARED WIN): "
 	}
 
 	// Display scores with winner highlighting
 	for _, player := range gm.Players {
+		if game.IsSolo(gm.Players) {
+			break
+		}
 		score := player.GetTotalScore()
 		scoreText := strconv.Itoa(score) + " points"
 
-		if score == maxScore {
+		if slices.Contains(winners, player) {
 			// Winner gets special styling
-			scoreLabel := widget.NewLabelWithStyle("🏆 "+player.Name+" (WINNER): "+scoreText, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
+			scoreLabel := widget.NewLabelWithStyle("🏆 "+player.Name+winnerTag+scoreText, fyne.TextAlignLeading, fyne.TextStyle{Bold: true})
 			scoreLabel.Importance = widget.HighImportance
 			content.Add(scoreLabel)
 		} else {
@@ -314,8 +453,11 @@ func createFinalScoresScreen(app fyne.App, window fyne.Window, gm *ui.GameManage
 
 	// Style buttons
 	newGameBtn := widget.NewButton("🆕 New Game", func() {
+		// The finished game is left behind, so it is no longer offered for resuming
+		endGame(db, gm)
+
 		// Clear navigation stack back to setup and create new game
-		for globalNav.Back() != nil {
+		for navigateBack() != nil {
 			// Keep going back until we reach root
 		}
 		setupScreen := createSetupScreen(app, window, db)
@@ -324,7 +466,7 @@ func createFinalScoresScreen(app fyne.App, window fyne.Window, gm *ui.GameManage
 	newGameBtn.Importance = widget.HighImportance
 
 	backToCalculatorBtn := widget.NewButton("📊 Calculator", func() {
-		globalNav.Back() // Go back to calculator
+		navigateBack() // Go back to calculator
 	})
 	backToCalculatorBtn.Importance = widget.MediumImportance
 
@@ -359,8 +501,22 @@ func saveGameDialog(db *storage.Database, gm *ui.GameManager, _ fyne.App, window
 			if err != nil {
 				dialog.ShowError(fmt.Errorf("Failed to save game: %v", err), window)
 			} else {
+				// The game is complete, so it is no longer offered for resuming. The calculator
+				// is closed too, since edits made after saving would be neither saved nor checkpointed.
+				endGame(db, gm)
+				for navigateBack() != nil {
+					// Keep going back until we reach the main menu
+				}
 				dialog.ShowInformation("Game Saved", "The game has been successfully saved to your history!", window)
 			}
 		}
 	}, window).Show()
 }
+
+// endGame stops checkpointing a finished game and removes its draft
+func endGame(db *storage.Database, gm *ui.GameManager) {
+	gm.SetAutosave(nil)
+	if err := db.ClearDraft(); err != nil {
+		slog.Error("Error clearing game in progress", "error", err)
+	}
+}